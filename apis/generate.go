@@ -15,8 +15,8 @@
 //go:build generate
 // +build generate
 
-// NOTE(negz): See the below link for details on what is happening here.
-// https://github.com/golang/go/wiki/Modules#how-can-i-track-tool-dependencies-for-a-module
+// The tools run below are tracked by the tool directives in go.mod rather than
+// imported here, so that they are not required by modules importing the apis.
 
 // Replicate identical API versions.
 //go:generate ../hack/duplicate_api_type.sh spaces/v1beta1/backup_types.go spaces/v1alpha1 true
@@ -37,10 +37,3 @@
 //go:generate go run -tags generate github.com/crossplane/crossplane-tools/cmd/angryjet generate-methodsets --header-file=../hack/boilerplate.go.txt ./...
 
 package generate
-
-import (
-	_ "github.com/google/addlicense"                    //nolint:typecheck
-	_ "sigs.k8s.io/controller-tools/cmd/controller-gen" //nolint:typecheck
-
-	_ "github.com/crossplane/crossplane-tools/cmd/angryjet" //nolint:typecheck
-)
@@ -82,12 +82,15 @@ require (
 	k8s.io/code-generator v0.33.2 // indirect
 	k8s.io/gengo/v2 v2.0.0-20250704022524-ddb642e17a28 // indirect
 	k8s.io/klog/v2 v2.130.1 // indirect
-	k8s.io/kube-openapi v0.0.0-20250710124328-f3f2b991d03b // indirect
+	k8s.io/kube-openapi v0.0.0-20250628140032-d90c4fd18f59 // indirect
 	sigs.k8s.io/json v0.0.0-20241014173422-cfa47c3a1cc8 // indirect
 	sigs.k8s.io/randfill v1.0.0 // indirect
 	sigs.k8s.io/structured-merge-diff/v4 v4.7.0 // indirect
 	sigs.k8s.io/yaml v1.5.0 // indirect
 )
 
-// Otherwise we're getting incompatibility errors with sigs.k8s.io/structured-merge-diff/v6 vs sigs.k8s.io/structured-merge-diff/v4
-replace k8s.io/kube-openapi => k8s.io/kube-openapi v0.0.0-20250628140032-d90c4fd18f59
+tool (
+	github.com/crossplane/crossplane-tools/cmd/angryjet
+	github.com/google/addlicense
+	sigs.k8s.io/controller-tools/cmd/controller-gen
+)
@@ -0,0 +1,525 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+const (
+	notInV1alpha2 = "cannot be expressed in v1alpha2"
+	notInV1alpha1 = "cannot be expressed in v1alpha1"
+
+	multipleFilters = "v1alpha1 only supports several filters that differ by id alone"
+	noStatus        = "v1alpha1 requires the status of a condition"
+)
+
+func init() {
+	SchemeBuilder.SchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions registers conversions between the v1alpha1 and v1alpha2
+// queries with the scheme. Conversions are lossless where possible, and
+// return an error naming every field that cannot be expressed in the target
+// version.
+func RegisterConversions(s *runtime.Scheme) error {
+	for _, c := range []struct {
+		a, b any
+		fn   conversion.ConversionFunc
+	}{
+		{(*SpaceQuery)(nil), (*v1alpha2.SpaceQuery)(nil), func(a, b any, s conversion.Scope) error {
+			return Convert_v1alpha1_SpaceQuery_To_v1alpha2_SpaceQuery(a.(*SpaceQuery), b.(*v1alpha2.SpaceQuery), s) //nolint:forcetypeassert // Registered for these types.
+		}},
+		{(*v1alpha2.SpaceQuery)(nil), (*SpaceQuery)(nil), func(a, b any, s conversion.Scope) error {
+			return Convert_v1alpha2_SpaceQuery_To_v1alpha1_SpaceQuery(a.(*v1alpha2.SpaceQuery), b.(*SpaceQuery), s) //nolint:forcetypeassert // Registered for these types.
+		}},
+		{(*GroupQuery)(nil), (*v1alpha2.GroupQuery)(nil), func(a, b any, s conversion.Scope) error {
+			return Convert_v1alpha1_GroupQuery_To_v1alpha2_GroupQuery(a.(*GroupQuery), b.(*v1alpha2.GroupQuery), s) //nolint:forcetypeassert // Registered for these types.
+		}},
+		{(*v1alpha2.GroupQuery)(nil), (*GroupQuery)(nil), func(a, b any, s conversion.Scope) error {
+			return Convert_v1alpha2_GroupQuery_To_v1alpha1_GroupQuery(a.(*v1alpha2.GroupQuery), b.(*GroupQuery), s) //nolint:forcetypeassert // Registered for these types.
+		}},
+		{(*Query)(nil), (*v1alpha2.Query)(nil), func(a, b any, s conversion.Scope) error {
+			return Convert_v1alpha1_Query_To_v1alpha2_Query(a.(*Query), b.(*v1alpha2.Query), s) //nolint:forcetypeassert // Registered for these types.
+		}},
+		{(*v1alpha2.Query)(nil), (*Query)(nil), func(a, b any, s conversion.Scope) error {
+			return Convert_v1alpha2_Query_To_v1alpha1_Query(a.(*v1alpha2.Query), b.(*Query), s) //nolint:forcetypeassert // Registered for these types.
+		}},
+		{(*QuerySpec)(nil), (*v1alpha2.QuerySpec)(nil), func(a, b any, s conversion.Scope) error {
+			return Convert_v1alpha1_QuerySpec_To_v1alpha2_QuerySpec(a.(*QuerySpec), b.(*v1alpha2.QuerySpec), s) //nolint:forcetypeassert // Registered for these types.
+		}},
+		{(*v1alpha2.QuerySpec)(nil), (*QuerySpec)(nil), func(a, b any, s conversion.Scope) error {
+			return Convert_v1alpha2_QuerySpec_To_v1alpha1_QuerySpec(a.(*v1alpha2.QuerySpec), b.(*QuerySpec), s) //nolint:forcetypeassert // Registered for these types.
+		}},
+	} {
+		if err := s.AddConversionFunc(c.a, c.b, c.fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SpaceQuery_To_v1alpha2_SpaceQuery converts a v1alpha1
+// SpaceQuery to v1alpha2.
+func Convert_v1alpha1_SpaceQuery_To_v1alpha2_SpaceQuery(in *SpaceQuery, out *v1alpha2.SpaceQuery, _ conversion.Scope) error { //nolint:revive,stylecheck // Named like generated conversions.
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Response = responseToV1alpha2(in.Response)
+	var errs field.ErrorList
+	out.Spec, errs = specToV1alpha2(in.Spec, field.NewPath("spec"))
+	return errs.ToAggregate()
+}
+
+// Convert_v1alpha2_SpaceQuery_To_v1alpha1_SpaceQuery converts a v1alpha2
+// SpaceQuery to v1alpha1.
+func Convert_v1alpha2_SpaceQuery_To_v1alpha1_SpaceQuery(in *v1alpha2.SpaceQuery, out *SpaceQuery, _ conversion.Scope) error { //nolint:revive,stylecheck // Named like generated conversions.
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Response = responseToV1alpha1(in.Response)
+	var errs field.ErrorList
+	out.Spec, errs = specToV1alpha1(in.Spec, field.NewPath("spec"))
+	return errs.ToAggregate()
+}
+
+// Convert_v1alpha1_GroupQuery_To_v1alpha2_GroupQuery converts a v1alpha1
+// GroupQuery to v1alpha2.
+func Convert_v1alpha1_GroupQuery_To_v1alpha2_GroupQuery(in *GroupQuery, out *v1alpha2.GroupQuery, _ conversion.Scope) error { //nolint:revive,stylecheck // Named like generated conversions.
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Response = responseToV1alpha2(in.Response)
+	var errs field.ErrorList
+	out.Spec, errs = specToV1alpha2(in.Spec, field.NewPath("spec"))
+	return errs.ToAggregate()
+}
+
+// Convert_v1alpha2_GroupQuery_To_v1alpha1_GroupQuery converts a v1alpha2
+// GroupQuery to v1alpha1.
+func Convert_v1alpha2_GroupQuery_To_v1alpha1_GroupQuery(in *v1alpha2.GroupQuery, out *GroupQuery, _ conversion.Scope) error { //nolint:revive,stylecheck // Named like generated conversions.
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Response = responseToV1alpha1(in.Response)
+	var errs field.ErrorList
+	out.Spec, errs = specToV1alpha1(in.Spec, field.NewPath("spec"))
+	return errs.ToAggregate()
+}
+
+// Convert_v1alpha1_Query_To_v1alpha2_Query converts a v1alpha1 Query to
+// v1alpha2.
+func Convert_v1alpha1_Query_To_v1alpha2_Query(in *Query, out *v1alpha2.Query, _ conversion.Scope) error { //nolint:revive,stylecheck // Named like generated conversions.
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Response = responseToV1alpha2(in.Response)
+	var errs field.ErrorList
+	out.Spec, errs = specToV1alpha2(in.Spec, field.NewPath("spec"))
+	return errs.ToAggregate()
+}
+
+// Convert_v1alpha2_Query_To_v1alpha1_Query converts a v1alpha2 Query to
+// v1alpha1.
+func Convert_v1alpha2_Query_To_v1alpha1_Query(in *v1alpha2.Query, out *Query, _ conversion.Scope) error { //nolint:revive,stylecheck // Named like generated conversions.
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Response = responseToV1alpha1(in.Response)
+	var errs field.ErrorList
+	out.Spec, errs = specToV1alpha1(in.Spec, field.NewPath("spec"))
+	return errs.ToAggregate()
+}
+
+// Convert_v1alpha1_QuerySpec_To_v1alpha2_QuerySpec converts a v1alpha1
+// QuerySpec to v1alpha2. The IDs and filter of the top level filter become
+// one v1alpha2 filter per ID. Owners and SQL filters cannot be converted.
+func Convert_v1alpha1_QuerySpec_To_v1alpha2_QuerySpec(in *QuerySpec, out *v1alpha2.QuerySpec, _ conversion.Scope) error { //nolint:revive,stylecheck // Named like generated conversions.
+	spec, errs := specToV1alpha2(in, field.NewPath("spec"))
+	if spec != nil {
+		*out = *spec
+	}
+	return errs.ToAggregate()
+}
+
+// Convert_v1alpha2_QuerySpec_To_v1alpha1_QuerySpec converts a v1alpha2
+// QuerySpec to v1alpha1. Several top level filters can only be converted if
+// they differ by ID alone. Label, JSONPath, creation timestamp and condition
+// reason filters cannot be converted.
+func Convert_v1alpha2_QuerySpec_To_v1alpha1_QuerySpec(in *v1alpha2.QuerySpec, out *QuerySpec, _ conversion.Scope) error { //nolint:revive,stylecheck // Named like generated conversions.
+	spec, errs := specToV1alpha1(in, field.NewPath("spec"))
+	if spec != nil {
+		*out = *spec
+	}
+	return errs.ToAggregate()
+}
+
+func specToV1alpha2(in *QuerySpec, p *field.Path) (*v1alpha2.QuerySpec, field.ErrorList) {
+	if in == nil {
+		return nil, nil
+	}
+	res, errs := resourcesToV1alpha2(&in.QueryResources, p)
+	out := &v1alpha2.QuerySpec{
+		QueryTopLevelResources: v1alpha2.QueryTopLevelResources{
+			QueryResources: res,
+			Filter: v1alpha2.QueryTopLevelFilter{
+				ControlPlane: v1alpha2.QueryFilterControlPlane{
+					Name:  in.Filter.ControlPlane.Name,
+					Group: in.Filter.ControlPlane.Namespace,
+				},
+			},
+		},
+	}
+	for _, f := range in.Freshness {
+		out.Freshness = append(out.Freshness, v1alpha2.Freshness(f))
+	}
+
+	fp := p.Child("filter")
+	f, ferrs := filterToV1alpha2(&in.Filter.QueryFilter, fp)
+	errs = append(errs, ferrs...)
+	switch {
+	case len(in.Filter.IDs) > 0:
+		// An object must match one of the IDs and the filter.
+		for _, id := range in.Filter.IDs {
+			idf := *f.DeepCopy()
+			idf.ID = id
+			out.Filter.Objects = append(out.Filter.Objects, idf)
+		}
+	case !reflect.ValueOf(f).IsZero():
+		out.Filter.Objects = []v1alpha2.QueryFilter{f}
+	}
+	return out, errs
+}
+
+func specToV1alpha1(in *v1alpha2.QuerySpec, p *field.Path) (*QuerySpec, field.ErrorList) {
+	if in == nil {
+		return nil, nil
+	}
+	res, errs := resourcesToV1alpha1(&in.QueryResources, p)
+	out := &QuerySpec{
+		QueryTopLevelResources: QueryTopLevelResources{
+			QueryResources: res,
+			Filter: QueryTopLevelFilter{
+				ControlPlane: QueryFilterControlPlane{
+					Name:      in.Filter.ControlPlane.Name,
+					Namespace: in.Filter.ControlPlane.Group,
+				},
+			},
+		},
+	}
+	for _, f := range in.Freshness {
+		out.Freshness = append(out.Freshness, Freshness(f))
+	}
+
+	op := p.Child("filter", "objects")
+	objs := in.Filter.Objects
+	if len(objs) == 0 {
+		return out, errs
+	}
+	// Several filters can only be expressed as IDs sharing one filter.
+	common := *objs[0].DeepCopy()
+	common.ID = ""
+	for i, f := range objs {
+		f := *f.DeepCopy()
+		if len(objs) > 1 && f.ID == "" {
+			return out, append(errs, field.Invalid(op.Index(i), f, multipleFilters))
+		}
+		if f.ID != "" {
+			out.Filter.IDs = append(out.Filter.IDs, f.ID)
+		}
+		f.ID = ""
+		if !reflect.DeepEqual(f, common) {
+			return out, append(errs, field.Invalid(op.Index(i), f, multipleFilters))
+		}
+	}
+	f, ferrs := filterToV1alpha1(&common, op.Index(0))
+	out.Filter.QueryFilter = f
+	return out, append(errs, ferrs...)
+}
+
+func resourcesToV1alpha2(in *QueryResources, p *field.Path) (v1alpha2.QueryResources, field.ErrorList) {
+	out := v1alpha2.QueryResources{
+		Count:  in.Count,
+		Limit:  in.Limit,
+		Page:   v1alpha2.QueryPage(in.Page),
+		Cursor: in.Cursor,
+	}
+	for _, o := range in.Order {
+		out.Order = append(out.Order, v1alpha2.QueryOrder{
+			CreationTimestamp: v1alpha2.Direction(o.CreationTimestamp),
+			Name:              v1alpha2.Direction(o.Name),
+			Namespace:         v1alpha2.Direction(o.Namespace),
+			APIGroup:          v1alpha2.Direction(o.APIGroup),
+			Kind:              v1alpha2.Direction(o.Kind),
+			Group:             v1alpha2.Direction(o.Group),
+			ControlPlane:      v1alpha2.Direction(o.ControlPlane),
+		})
+	}
+	if in.Objects == nil {
+		return out, nil
+	}
+	var errs field.ErrorList
+	out.Objects = &v1alpha2.QueryObjects{
+		ID:           in.Objects.ID,
+		MutablePath:  in.Objects.MutablePath,
+		ControlPlane: in.Objects.ControlPlane,
+		Object:       in.Objects.Object.DeepCopy(),
+	}
+	if in.Objects.Table != nil {
+		out.Objects.Table = &v1alpha2.QueryTable{Grouping: v1alpha2.QueryGrouping(in.Objects.Table.Grouping)}
+	}
+	for name, r := range in.Objects.Relations {
+		rp := p.Child("objects", "relations").Key(name)
+		res, rerrs := resourcesToV1alpha2(&r.QueryResources, rp)
+		errs = append(errs, rerrs...)
+		rel := v1alpha2.QueryRelation{}
+		rel.QueryResources = res
+		f, ferrs := filterToV1alpha2(&r.Filter, rp.Child("filter"))
+		errs = append(errs, ferrs...)
+		if !reflect.ValueOf(f).IsZero() {
+			rel.Filters = []v1alpha2.QueryFilter{f}
+		}
+		if out.Objects.Relations == nil {
+			out.Objects.Relations = map[string]v1alpha2.QueryRelation{}
+		}
+		out.Objects.Relations[name] = rel
+	}
+	return out, errs
+}
+
+func resourcesToV1alpha1(in *v1alpha2.QueryResources, p *field.Path) (QueryResources, field.ErrorList) {
+	out := QueryResources{
+		Count:  in.Count,
+		Limit:  in.Limit,
+		Page:   QueryPage(in.Page),
+		Cursor: in.Cursor,
+	}
+	for _, o := range in.Order {
+		out.Order = append(out.Order, QueryOrder{
+			CreationTimestamp: Direction(o.CreationTimestamp),
+			Name:              Direction(o.Name),
+			Namespace:         Direction(o.Namespace),
+			APIGroup:          Direction(o.APIGroup),
+			Kind:              Direction(o.Kind),
+			Group:             Direction(o.Group),
+			ControlPlane:      Direction(o.ControlPlane),
+		})
+	}
+	if in.Objects == nil {
+		return out, nil
+	}
+	var errs field.ErrorList
+	out.Objects = &QueryObjects{
+		ID:           in.Objects.ID,
+		MutablePath:  in.Objects.MutablePath,
+		ControlPlane: in.Objects.ControlPlane,
+		Object:       in.Objects.Object.DeepCopy(),
+	}
+	if in.Objects.Table != nil {
+		out.Objects.Table = &QueryTable{Grouping: QueryGrouping(in.Objects.Table.Grouping)}
+	}
+	for name, r := range in.Objects.Relations {
+		rp := p.Child("objects", "relations").Key(name)
+		res, rerrs := resourcesToV1alpha1(&r.QueryResources, rp)
+		errs = append(errs, rerrs...)
+		rel := QueryRelation{}
+		rel.QueryResources = res
+		switch len(r.Filters) {
+		case 0:
+		case 1:
+			fp := rp.Child("filters").Index(0)
+			if r.Filters[0].ID != "" {
+				errs = append(errs, field.Forbidden(fp.Child("id"), notInV1alpha1))
+			}
+			f, ferrs := filterToV1alpha1(&r.Filters[0], fp)
+			errs = append(errs, ferrs...)
+			rel.Filter = f
+		default:
+			errs = append(errs, field.TooMany(rp.Child("filters"), len(r.Filters), 1))
+		}
+		if out.Objects.Relations == nil {
+			out.Objects.Relations = map[string]QueryRelation{}
+		}
+		out.Objects.Relations[name] = rel
+	}
+	return out, errs
+}
+
+func filterToV1alpha2(in *QueryFilter, p *field.Path) (v1alpha2.QueryFilter, field.ErrorList) {
+	var errs field.ErrorList
+	if len(in.Owners) > 0 {
+		errs = append(errs, field.Forbidden(p.Child("owners"), notInV1alpha2))
+	}
+	if in.SQL != "" {
+		errs = append(errs, field.Forbidden(p.Child("sql"), notInV1alpha2))
+	}
+	out := v1alpha2.QueryFilter{
+		Namespace:  in.Namespace,
+		Name:       in.Name,
+		GroupKind:  v1alpha2.QueryGroupKind{APIGroup: in.Group, Kind: in.Kind},
+		Categories: append([]string(nil), in.Categories...),
+	}
+	for _, c := range in.Conditions {
+		out.Conditions = append(out.Conditions, v1alpha2.QueryCondition{Type: c.Type, Status: c.Status})
+	}
+	return out, errs
+}
+
+func filterToV1alpha1(in *v1alpha2.QueryFilter, p *field.Path) (QueryFilter, field.ErrorList) {
+	var errs field.ErrorList
+	if in.CreationTimestamp != (v1alpha2.QueryCreationTimestamp{}) {
+		errs = append(errs, field.Forbidden(p.Child("creationTimestamp"), notInV1alpha1))
+	}
+	if len(in.Labels) > 0 {
+		errs = append(errs, field.Forbidden(p.Child("labels"), notInV1alpha1))
+	}
+	if in.JSONPath != "" {
+		errs = append(errs, field.Forbidden(p.Child("jsonpath"), notInV1alpha1))
+	}
+	out := QueryFilter{
+		Namespace:  in.Namespace,
+		Name:       in.Name,
+		Group:      in.GroupKind.APIGroup,
+		Kind:       in.GroupKind.Kind,
+		Categories: append([]string(nil), in.Categories...),
+	}
+	for i, c := range in.Conditions {
+		cp := p.Child("conditions").Index(i)
+		if c.Reason != "" {
+			errs = append(errs, field.Forbidden(cp.Child("reason"), notInV1alpha1))
+		}
+		if c.Status == "" {
+			errs = append(errs, field.Required(cp.Child("status"), noStatus))
+		}
+		out.Conditions = append(out.Conditions, QueryCondition{Type: c.Type, Status: c.Status})
+	}
+	return out, errs
+}
+
+// The response types of both versions are identical.
+
+func responseToV1alpha2(in *QueryResponse) *v1alpha2.QueryResponse {
+	if in == nil {
+		return nil
+	}
+	return &v1alpha2.QueryResponse{
+		Warnings:             append([]string(nil), in.Warnings...),
+		QueryResponseObjects: responseObjectsToV1alpha2(&in.QueryResponseObjects),
+	}
+}
+
+func responseObjectsToV1alpha2(in *QueryResponseObjects) v1alpha2.QueryResponseObjects {
+	out := v1alpha2.QueryResponseObjects{
+		Incomplete: in.Incomplete,
+	}
+	if in.Cursor != nil {
+		c := v1alpha2.QueryResponseCursor(*in.Cursor)
+		out.Cursor = &c
+	}
+	if in.Count != nil {
+		c := *in.Count
+		out.Count = &c
+	}
+	for _, t := range in.Tables {
+		out.Tables = append(out.Tables, v1alpha2.QueryResponseTable{
+			GroupVersionKind: t.GroupVersionKind,
+			Columns:          append([]metav1.TableColumnDefinition(nil), t.Columns...),
+			Rows:             deepCopyRows(t.Rows),
+		})
+	}
+	for _, o := range in.Objects {
+		obj := v1alpha2.QueryResponseObject{
+			ID:     o.ID,
+			Object: o.Object.DeepCopy(),
+			Errors: append([]string(nil), o.Errors...),
+		}
+		if o.MutablePath != nil {
+			mp := v1alpha2.QueryResponseMutablePath(*o.MutablePath)
+			obj.MutablePath = &mp
+		}
+		if o.ControlPlane != nil {
+			cp := v1alpha2.QueryResponseControlPlane(*o.ControlPlane)
+			obj.ControlPlane = &cp
+		}
+		for name, r := range o.Relations {
+			if obj.Relations == nil {
+				obj.Relations = map[string]v1alpha2.QueryResponseRelation{}
+			}
+			obj.Relations[name] = v1alpha2.QueryResponseRelation{QueryResponseObjects: responseObjectsToV1alpha2(&r.QueryResponseObjects)}
+		}
+		out.Objects = append(out.Objects, obj)
+	}
+	return out
+}
+
+func responseToV1alpha1(in *v1alpha2.QueryResponse) *QueryResponse {
+	if in == nil {
+		return nil
+	}
+	return &QueryResponse{
+		Warnings:             append([]string(nil), in.Warnings...),
+		QueryResponseObjects: responseObjectsToV1alpha1(&in.QueryResponseObjects),
+	}
+}
+
+func responseObjectsToV1alpha1(in *v1alpha2.QueryResponseObjects) QueryResponseObjects {
+	out := QueryResponseObjects{
+		Incomplete: in.Incomplete,
+	}
+	if in.Cursor != nil {
+		c := QueryResponseCursor(*in.Cursor)
+		out.Cursor = &c
+	}
+	if in.Count != nil {
+		c := *in.Count
+		out.Count = &c
+	}
+	for _, t := range in.Tables {
+		out.Tables = append(out.Tables, QueryResponseTable{
+			GroupVersionKind: t.GroupVersionKind,
+			Columns:          append([]metav1.TableColumnDefinition(nil), t.Columns...),
+			Rows:             deepCopyRows(t.Rows),
+		})
+	}
+	for _, o := range in.Objects {
+		obj := QueryResponseObject{
+			ID:     o.ID,
+			Object: o.Object.DeepCopy(),
+			Errors: append([]string(nil), o.Errors...),
+		}
+		if o.MutablePath != nil {
+			mp := QueryResponseMutablePath(*o.MutablePath)
+			obj.MutablePath = &mp
+		}
+		if o.ControlPlane != nil {
+			cp := QueryResponseControlPlane(*o.ControlPlane)
+			obj.ControlPlane = &cp
+		}
+		for name, r := range o.Relations {
+			if obj.Relations == nil {
+				obj.Relations = map[string]QueryResponseRelation{}
+			}
+			obj.Relations[name] = QueryResponseRelation{QueryResponseObjects: responseObjectsToV1alpha1(&r.QueryResponseObjects)}
+		}
+		out.Objects = append(out.Objects, obj)
+	}
+	return out
+}
+
+func deepCopyRows(rows []metav1.TableRow) []metav1.TableRow {
+	if rows == nil {
+		return nil
+	}
+	out := make([]metav1.TableRow, len(rows))
+	for i := range rows {
+		rows[i].DeepCopyInto(&out[i])
+	}
+	return out
+}
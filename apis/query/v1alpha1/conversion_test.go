@@ -0,0 +1,286 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+	"github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+// equateErrorMessages treats errors as equal when their messages are, since
+// aggregated field errors cannot be compared with errors.Is.
+var equateErrorMessages = cmp.Comparer(func(a, b error) bool { //nolint:gochecknoglobals // This is intended to be global.
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Error() == b.Error()
+})
+
+func TestConvertToV1alpha2(t *testing.T) {
+	type want struct {
+		spec *v1alpha2.QuerySpec
+		err  error
+	}
+	cases := map[string]struct {
+		reason string
+		in     *QuerySpec
+		want   want
+	}{
+		"IDs": {
+			reason: "Every ID should become a filter that also carries the shared criteria.",
+			in: &QuerySpec{
+				QueryTopLevelResources: QueryTopLevelResources{
+					QueryResources: QueryResources{
+						Limit: 10,
+						Order: []QueryOrder{{Name: Descending}},
+						Objects: &QueryObjects{
+							ID:     true,
+							Object: &common.JSON{Object: true},
+							Relations: map[string]QueryRelation{
+								"owners": {QueryNestedResources: QueryNestedResources{
+									QueryResources: QueryResources{Objects: &QueryObjects{ID: true}},
+									Filter:         QueryFilter{Kind: "XNetwork"},
+								}},
+							},
+						},
+					},
+					Filter: QueryTopLevelFilter{
+						ControlPlane: QueryFilterControlPlane{Name: "ctp", Namespace: "default"},
+						IDs:          []string{"a", "b"},
+						QueryFilter: QueryFilter{
+							Group:      "apiextensions.crossplane.io",
+							Kind:       "Composition",
+							Conditions: []QueryCondition{{Type: "Ready", Status: "True"}},
+						},
+					},
+				},
+				Freshness: []Freshness{{Group: "default", ControlPlane: "ctp", ResourceVersion: "1"}},
+			},
+			want: want{spec: &v1alpha2.QuerySpec{
+				QueryTopLevelResources: v1alpha2.QueryTopLevelResources{
+					QueryResources: v1alpha2.QueryResources{
+						Limit: 10,
+						Order: []v1alpha2.QueryOrder{{Name: v1alpha2.Descending}},
+						Objects: &v1alpha2.QueryObjects{
+							ID:     true,
+							Object: &common.JSON{Object: true},
+							Relations: map[string]v1alpha2.QueryRelation{
+								"owners": {QueryNestedResources: v1alpha2.QueryNestedResources{
+									QueryResources: v1alpha2.QueryResources{Objects: &v1alpha2.QueryObjects{ID: true}},
+									Filters:        []v1alpha2.QueryFilter{{GroupKind: v1alpha2.QueryGroupKind{Kind: "XNetwork"}}},
+								}},
+							},
+						},
+					},
+					Filter: v1alpha2.QueryTopLevelFilter{
+						ControlPlane: v1alpha2.QueryFilterControlPlane{Name: "ctp", Group: "default"},
+						Objects: []v1alpha2.QueryFilter{
+							{
+								ID:         "a",
+								GroupKind:  v1alpha2.QueryGroupKind{APIGroup: "apiextensions.crossplane.io", Kind: "Composition"},
+								Conditions: []v1alpha2.QueryCondition{{Type: "Ready", Status: "True"}},
+							},
+							{
+								ID:         "b",
+								GroupKind:  v1alpha2.QueryGroupKind{APIGroup: "apiextensions.crossplane.io", Kind: "Composition"},
+								Conditions: []v1alpha2.QueryCondition{{Type: "Ready", Status: "True"}},
+							},
+						},
+					},
+				},
+				Freshness: []v1alpha2.Freshness{{Group: "default", ControlPlane: "ctp", ResourceVersion: "1"}},
+			}},
+		},
+		"Unsupported": {
+			reason: "Owners and SQL filters should be reported, wherever they are.",
+			in: &QuerySpec{
+				QueryTopLevelResources: QueryTopLevelResources{
+					QueryResources: QueryResources{Objects: &QueryObjects{
+						Relations: map[string]QueryRelation{
+							"events": {QueryNestedResources: QueryNestedResources{Filter: QueryFilter{SQL: "true"}}},
+						},
+					}},
+					Filter: QueryTopLevelFilter{QueryFilter: QueryFilter{Owners: []QueryOwner{{UID: "u"}}}},
+				},
+			},
+			want: want{err: utilerrors.NewAggregate([]error{
+				field.Forbidden(field.NewPath("spec", "objects", "relations").Key("events").Child("filter", "sql"), notInV1alpha2),
+				field.Forbidden(field.NewPath("spec", "filter", "owners"), notInV1alpha2),
+			})},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &v1alpha2.QuerySpec{}
+			err := Convert_v1alpha1_QuerySpec_To_v1alpha2_QuerySpec(tc.in, got, nil)
+			if diff := cmp.Diff(tc.want.err, err, equateErrorMessages); diff != "" {
+				t.Errorf("\n%s\nConvert(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.spec, got); diff != "" {
+				t.Errorf("\n%s\nConvert(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConvertToV1alpha1(t *testing.T) {
+	type want struct {
+		spec *QuerySpec
+		err  string
+	}
+	cases := map[string]struct {
+		reason string
+		in     *v1alpha2.QuerySpec
+		want   want
+	}{
+		"IDs": {
+			reason: "Filters that differ by ID alone should become IDs sharing one filter.",
+			in: &v1alpha2.QuerySpec{QueryTopLevelResources: v1alpha2.QueryTopLevelResources{
+				QueryResources: v1alpha2.QueryResources{Count: true},
+				Filter: v1alpha2.QueryTopLevelFilter{
+					ControlPlane: v1alpha2.QueryFilterControlPlane{Group: "default"},
+					Objects: []v1alpha2.QueryFilter{
+						{ID: "a", Namespace: "ns"},
+						{ID: "b", Namespace: "ns"},
+					},
+				},
+			}},
+			want: want{spec: &QuerySpec{QueryTopLevelResources: QueryTopLevelResources{
+				QueryResources: QueryResources{Count: true},
+				Filter: QueryTopLevelFilter{
+					ControlPlane: QueryFilterControlPlane{Namespace: "default"},
+					IDs:          []string{"a", "b"},
+					QueryFilter:  QueryFilter{Namespace: "ns"},
+				},
+			}}},
+		},
+		"DifferentFilters": {
+			reason: "Filters that differ by more than their ID cannot be expressed.",
+			in: &v1alpha2.QuerySpec{QueryTopLevelResources: v1alpha2.QueryTopLevelResources{
+				Filter: v1alpha2.QueryTopLevelFilter{Objects: []v1alpha2.QueryFilter{{Name: "a"}, {Name: "b"}}},
+			}},
+			want: want{err: `spec.filter.objects[0]: Invalid value: v1alpha2.QueryFilter{`},
+		},
+		"Unsupported": {
+			reason: "Label, JSONPath, creation timestamp and condition reason filters should be reported.",
+			in: &v1alpha2.QuerySpec{QueryTopLevelResources: v1alpha2.QueryTopLevelResources{
+				Filter: v1alpha2.QueryTopLevelFilter{Objects: []v1alpha2.QueryFilter{{
+					CreationTimestamp: v1alpha2.QueryCreationTimestamp{After: metav1.NewTime(time.Unix(0, 0))},
+					Labels:            map[string]string{"a": "b"},
+					JSONPath:          "$.spec.paused",
+					Conditions:        []v1alpha2.QueryCondition{{Type: "Ready", Reason: "Available"}},
+				}}},
+			}},
+			want: want{err: "[" +
+				"spec.filter.objects[0].creationTimestamp: Forbidden: cannot be expressed in v1alpha1, " +
+				"spec.filter.objects[0].labels: Forbidden: cannot be expressed in v1alpha1, " +
+				"spec.filter.objects[0].jsonpath: Forbidden: cannot be expressed in v1alpha1, " +
+				"spec.filter.objects[0].conditions[0].reason: Forbidden: cannot be expressed in v1alpha1, " +
+				"spec.filter.objects[0].conditions[0].status: Required value: v1alpha1 requires the status of a condition]"},
+		},
+		"RelationFilters": {
+			reason: "A relation with several filters cannot be expressed.",
+			in: &v1alpha2.QuerySpec{QueryTopLevelResources: v1alpha2.QueryTopLevelResources{
+				QueryResources: v1alpha2.QueryResources{Objects: &v1alpha2.QueryObjects{
+					Relations: map[string]v1alpha2.QueryRelation{
+						"owners": {QueryNestedResources: v1alpha2.QueryNestedResources{Filters: []v1alpha2.QueryFilter{{Name: "a"}, {Name: "b"}}}},
+					},
+				}},
+			}},
+			want: want{err: "spec.objects.relations[owners].filters: Too many: 2: must have at most 1 items"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &QuerySpec{}
+			err := Convert_v1alpha2_QuerySpec_To_v1alpha1_QuerySpec(tc.in, got, nil)
+			gotErr := ""
+			if err != nil {
+				gotErr = err.Error()
+			}
+			if len(gotErr) > len(tc.want.err) && tc.want.err != "" {
+				gotErr = gotErr[:len(tc.want.err)]
+			}
+			if diff := cmp.Diff(tc.want.err, gotErr); diff != "" {
+				t.Errorf("\n%s\nConvert(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.err != "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.spec, got); diff != "" {
+				t.Errorf("\n%s\nConvert(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSchemeConversion(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1alpha2.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	count := 3
+	in := &Query{
+		ObjectMeta: metav1.ObjectMeta{Name: "ctp", Namespace: "default"},
+		Spec: &QuerySpec{QueryTopLevelResources: QueryTopLevelResources{
+			QueryResources: QueryResources{Objects: &QueryObjects{ID: true}},
+			Filter:         QueryTopLevelFilter{QueryFilter: QueryFilter{Kind: "Composition"}},
+		}},
+		Response: &QueryResponse{
+			Warnings: []string{"slow"},
+			QueryResponseObjects: QueryResponseObjects{
+				Count: &count,
+				Objects: []QueryResponseObject{{
+					ID:           "a",
+					ControlPlane: &QueryResponseControlPlane{Name: "ctp", Namespace: "default"},
+					Relations: map[string]QueryResponseRelation{
+						"owners": {QueryResponseObjects: QueryResponseObjects{Objects: []QueryResponseObject{{ID: "b"}}}},
+					},
+				}},
+			},
+		},
+	}
+
+	v2 := &v1alpha2.Query{}
+	if err := s.Convert(in, v2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]v1alpha2.QueryFilter{{GroupKind: v1alpha2.QueryGroupKind{Kind: "Composition"}}}, v2.Spec.Filter.Objects); diff != "" {
+		t.Errorf("\nConvert(...): -want, +got:\n%s", diff)
+	}
+
+	back := &Query{}
+	if err := s.Convert(v2, back, nil); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(in, back); diff != "" {
+		t.Errorf("\nConvert(...): a round trip should be lossless: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,310 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/organizations"
+	"github.com/upbound/up-sdk-go/service/repositorypermission"
+	"github.com/upbound/up-sdk-go/service/robots"
+	"github.com/upbound/up-sdk-go/service/teams"
+)
+
+const (
+	errNoTeam      = "team %q does not exist"
+	errNoRobot     = "robot %q does not exist"
+	errNoUser      = "user %q is not a member of the organization"
+	errNoInvite    = "invite for %q does not exist"
+	errUnknownStep = "cannot %s %s"
+	errNoState     = "plan has no live state; build it with Applier.Plan"
+)
+
+// An Applier plans and applies the desired state of an organization.
+type Applier struct {
+	api api
+}
+
+// NewApplier builds an Applier that uses the Upbound API described by cfg.
+func NewApplier(cfg *up.Config) *Applier {
+	return &Applier{api: newClients(cfg)}
+}
+
+// Plan reads the live state of the organization named by the document and
+// returns the steps that converge it to the document. Nothing is changed.
+func (a *Applier) Plan(ctx context.Context, d *Document) (*Plan, error) {
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	s, err := load(ctx, a.api, d)
+	if err != nil {
+		return nil, err
+	}
+	return &Plan{Organization: d.Organization, Steps: diff(d, s), State: s}, nil
+}
+
+// An ApplyOption modifies how a plan is applied.
+type ApplyOption func(*applyOptions)
+
+type applyOptions struct {
+	dryRun bool
+}
+
+// WithDryRun reports the steps of the plan without executing them.
+func WithDryRun() ApplyOption {
+	return func(o *applyOptions) {
+		o.dryRun = true
+	}
+}
+
+// A Result is the outcome of a step.
+type Result struct {
+	Step Step
+
+	// Err is the error that caused the step to fail, if any.
+	Err error
+}
+
+// A Report is the outcome of applying a plan.
+type Report struct {
+	DryRun  bool
+	Results []Result
+}
+
+// Failed returns the results of the steps that failed.
+func (r *Report) Failed() []Result {
+	var failed []Result
+	for _, res := range r.Results {
+		if res.Err != nil {
+			failed = append(failed, res)
+		}
+	}
+	return failed
+}
+
+// Err returns an error describing every failed step, or nil if every step
+// succeeded. The errors of the failed steps can be matched with errors.Is and
+// errors.As.
+func (r *Report) Err() error {
+	var errs stepErrors
+	for _, res := range r.Failed() {
+		errs = append(errs, errors.Wrap(res.Err, res.Step.String()))
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// stepErrors are the errors of the failed steps of a plan, one per line.
+type stepErrors []error
+
+func (e stepErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the errors of the failed steps.
+func (e stepErrors) Unwrap() []error {
+	return e
+}
+
+// Apply executes the steps of the plan in order. A failed step does not stop
+// the steps after it, except that steps acting on a team or robot that could
+// not be created fail too. Every step fails once ctx is done, or if the plan
+// has no State.
+func (a *Applier) Apply(ctx context.Context, p *Plan, opts ...ApplyOption) *Report {
+	o := &applyOptions{}
+	for _, fn := range opts {
+		fn(o)
+	}
+	r := &Report{DryRun: o.dryRun, Results: make([]Result, 0, len(p.Steps))}
+	e := newExecutor(a.api, p)
+	for _, s := range p.Steps {
+		res := Result{Step: s}
+		switch {
+		case o.dryRun:
+		case p.State == nil:
+			res.Err = errors.New(errNoState)
+		case ctx.Err() != nil:
+			res.Err = ctx.Err()
+		default:
+			res.Err = e.execute(ctx, s)
+		}
+		r.Results = append(r.Results, res)
+	}
+	return r
+}
+
+// executor executes steps, tracking the IDs of the teams and robots it
+// creates.
+type executor struct {
+	api    api
+	org    string
+	state  *State
+	teams  map[string]uuid.UUID
+	robots map[string]uuid.UUID
+}
+
+func newExecutor(a api, p *Plan) *executor {
+	e := &executor{
+		api:    a,
+		org:    p.Organization,
+		state:  p.State,
+		teams:  map[string]uuid.UUID{},
+		robots: map[string]uuid.UUID{},
+	}
+	if e.state == nil {
+		e.state = &State{}
+	}
+	for name, t := range e.state.Teams {
+		e.teams[name] = t.ID
+	}
+	for name, r := range e.state.Robots {
+		e.robots[name] = r.ID
+	}
+	return e
+}
+
+func (e *executor) execute(ctx context.Context, s Step) error { //nolint:gocyclo // A flat switch over every kind and action is easier to follow.
+	switch s.Kind {
+	case KindRobot:
+		return e.robot(ctx, s)
+	case KindTeam:
+		return e.team(ctx, s)
+	case KindInvite:
+		return e.invite(ctx, s)
+	}
+
+	team, ok := e.teams[s.Team]
+	if !ok {
+		return errors.Errorf(errNoTeam, s.Team)
+	}
+	switch {
+	case s.Kind == KindTeamMember && s.Action == ActionCreate:
+		user, ok := e.state.Users[s.Name]
+		if !ok {
+			return errors.Errorf(errNoUser, s.Name)
+		}
+		return e.api.AddTeamMember(ctx, team, &teams.TeamMemberParameters{UserID: user})
+	case s.Kind == KindTeamMember && s.Action == ActionDelete:
+		return e.api.RemoveTeamMember(ctx, team, e.state.Teams[s.Team].Members[s.Name])
+	case s.Kind == KindTeamRobot && s.Action == ActionCreate:
+		robot, ok := e.robots[s.Name]
+		if !ok {
+			return errors.Errorf(errNoRobot, s.Name)
+		}
+		return e.api.AddTeamRobot(ctx, team, robot)
+	case s.Kind == KindTeamRobot && s.Action == ActionDelete:
+		return e.api.RemoveTeamRobot(ctx, team, e.state.Teams[s.Team].Robots[s.Name])
+	case s.Kind == KindRepositoryPermission && s.Action == ActionDelete:
+		return e.api.DeletePermission(ctx, e.org, team, repositorypermission.PermissionIdentifier{Repository: s.Name})
+	case s.Kind == KindRepositoryPermission:
+		return e.api.SetPermission(ctx, e.org, team, repositorypermission.CreatePermission{
+			Repository: s.Name,
+			Permission: repositorypermission.RepositoryPermission{Permission: repositorypermission.PermissionType(s.To)},
+		})
+	}
+	return errors.Errorf(errUnknownStep, s.Action, s.Kind)
+}
+
+func (e *executor) robot(ctx context.Context, s Step) error {
+	switch s.Action {
+	case ActionCreate:
+		r, err := e.api.CreateRobot(ctx, &robots.RobotCreateParameters{
+			Attributes: robots.RobotAttributes{Name: s.Name, Description: s.To},
+			Relationships: robots.RobotRelationships{
+				Owner: robots.RobotOwner{
+					Data: robots.RobotOwnerData{
+						Type: robots.RobotOwnerOrganization,
+						ID:   strconv.FormatUint(uint64(e.state.OrgID), 10),
+					},
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		e.robots[s.Name] = r.ID
+		return nil
+	case ActionUpdate:
+		_, err := e.api.UpdateRobot(ctx, e.robots[s.Name], &robots.RobotAttributes{Name: s.Name, Description: s.To})
+		return err
+	case ActionDelete:
+		return e.api.DeleteRobot(ctx, e.robots[s.Name])
+	}
+	return errors.Errorf(errUnknownStep, s.Action, s.Kind)
+}
+
+func (e *executor) team(ctx context.Context, s Step) error {
+	switch s.Action {
+	case ActionCreate:
+		t, err := e.api.CreateTeam(ctx, &teams.TeamCreateParameters{Name: s.Name, OrganizationID: e.state.OrgID})
+		if err != nil {
+			return err
+		}
+		e.teams[s.Name] = t.ID
+		if s.To == "" {
+			return nil
+		}
+		// Teams are created without a description.
+		_, err = e.api.UpdateTeam(ctx, t.ID, &teams.TeamAttributes{Name: s.Name, Description: s.To})
+		return err
+	case ActionUpdate:
+		_, err := e.api.UpdateTeam(ctx, e.teams[s.Name], &teams.TeamAttributes{Name: s.Name, Description: s.To})
+		return err
+	case ActionDelete:
+		return e.api.DeleteTeam(ctx, e.teams[s.Name])
+	}
+	return errors.Errorf(errUnknownStep, s.Action, s.Kind)
+}
+
+func (e *executor) invite(ctx context.Context, s Step) error {
+	create := func() error {
+		return e.api.CreateInvite(ctx, e.state.OrgID, &organizations.OrganizationInviteCreateParameters{
+			Email:      s.Name,
+			Permission: organizations.OrganizationPermissionGroup(s.To),
+		})
+	}
+	remove := func() error {
+		i, ok := e.state.Invites[s.Name]
+		if !ok {
+			return errors.Errorf(errNoInvite, s.Name)
+		}
+		return e.api.DeleteInvite(ctx, e.state.OrgID, i.ID)
+	}
+	switch s.Action {
+	case ActionCreate:
+		return create()
+	case ActionUpdate:
+		// Invites cannot be updated, so they are sent again.
+		if err := remove(); err != nil {
+			return err
+		}
+		return create()
+	case ActionDelete:
+		return remove()
+	}
+	return errors.Errorf(errUnknownStep, s.Action, s.Kind)
+}
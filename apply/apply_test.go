@@ -0,0 +1,384 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/fake"
+	"github.com/upbound/up-sdk-go/service/common"
+	"github.com/upbound/up-sdk-go/service/organizations"
+	"github.com/upbound/up-sdk-go/service/repositorypermission"
+	"github.com/upbound/up-sdk-go/service/robots"
+	"github.com/upbound/up-sdk-go/service/teams"
+)
+
+var (
+	platformID = uuid.MustParse("4654b8b5-c01d-4fbe-8800-22c347c21383")
+	legacyID   = uuid.MustParse("b1a5c1c5-3a5e-4b8a-9b3a-6d0f1b6d2f11")
+	ciID       = uuid.MustParse("0d6b2b8e-4b8a-4c39-9d55-8d3f4e1c2a10")
+	newID      = uuid.MustParse("9a0e8f4c-0c53-4a2b-8a3c-0f6b5e9d1c22")
+)
+
+// fakeAPI serves a fixed organization and records every change.
+type fakeAPI struct {
+	calls []string
+	err   map[string]error
+}
+
+func (f *fakeAPI) record(call string, args ...any) error {
+	f.calls = append(f.calls, fmt.Sprintf(call, args...))
+	return f.err[call]
+}
+
+func (f *fakeAPI) GetOrgID(_ context.Context, _ string) (uint, error) { return 1, nil }
+
+func (f *fakeAPI) ListOrgMembers(_ context.Context, _ uint) ([]organizations.Member, error) {
+	return []organizations.Member{
+		{User: organizations.User{ID: 10, Username: "alice"}},
+		{User: organizations.User{ID: 11, Username: "bob"}},
+	}, nil
+}
+
+func (f *fakeAPI) ListInvites(_ context.Context, _ uint) ([]organizations.Invite, error) {
+	return []organizations.Invite{
+		{ID: 20, Email: "carol@example.com", Permission: organizations.OrganizationMember},
+		{ID: 21, Email: "dave@example.com", Permission: organizations.OrganizationMember},
+	}, nil
+}
+
+func (f *fakeAPI) CreateInvite(_ context.Context, _ uint, p *organizations.OrganizationInviteCreateParameters) error {
+	return f.record("CreateInvite %s %s", p.Email, p.Permission)
+}
+
+func (f *fakeAPI) DeleteInvite(_ context.Context, _ uint, id uint) error {
+	return f.record("DeleteInvite %d", id)
+}
+
+func (f *fakeAPI) ListTeams(_ context.Context, _ uint) ([]teams.TeamResponse, error) {
+	return []teams.TeamResponse{
+		{ID: platformID, Name: "platform", Description: "old"},
+		{ID: legacyID, Name: "legacy"},
+	}, nil
+}
+
+func (f *fakeAPI) CreateTeam(_ context.Context, p *teams.TeamCreateParameters) (*teams.TeamResponse, error) {
+	return &teams.TeamResponse{ID: newID}, f.record("CreateTeam %s", p.Name)
+}
+
+func (f *fakeAPI) UpdateTeam(_ context.Context, id uuid.UUID, p *teams.TeamAttributes) (*teams.TeamResponse, error) {
+	return &teams.TeamResponse{}, f.record("UpdateTeam %s %s", id, p.Description)
+}
+
+func (f *fakeAPI) DeleteTeam(_ context.Context, id uuid.UUID) error {
+	return f.record("DeleteTeam %s", id)
+}
+
+func (f *fakeAPI) ListTeamMembers(_ context.Context, id uuid.UUID) ([]teams.TeamMember, error) {
+	if id == platformID {
+		return []teams.TeamMember{{ID: 11, Username: "bob"}}, nil
+	}
+	return nil, nil
+}
+
+func (f *fakeAPI) AddTeamMember(_ context.Context, id uuid.UUID, p *teams.TeamMemberParameters) error {
+	return f.record("AddTeamMember %s %d", id, p.UserID)
+}
+
+func (f *fakeAPI) RemoveTeamMember(_ context.Context, id uuid.UUID, user uint) error {
+	return f.record("RemoveTeamMember %s %d", id, user)
+}
+
+func (f *fakeAPI) ListTeamRobots(_ context.Context, _ uuid.UUID) ([]teams.TeamRobot, error) {
+	return nil, nil
+}
+
+func (f *fakeAPI) AddTeamRobot(_ context.Context, id, robot uuid.UUID) error {
+	return f.record("AddTeamRobot %s %s", id, robot)
+}
+
+func (f *fakeAPI) RemoveTeamRobot(_ context.Context, id, robot uuid.UUID) error {
+	return f.record("RemoveTeamRobot %s %s", id, robot)
+}
+
+func (f *fakeAPI) ListRobots(_ context.Context, _ uint) ([]common.DataSet, error) {
+	return []common.DataSet{
+		{ID: ciID, AttributeSet: common.AttributeSet{"name": "ci", "description": "builds"}},
+	}, nil
+}
+
+func (f *fakeAPI) CreateRobot(_ context.Context, p *robots.RobotCreateParameters) (*robots.RobotResponse, error) {
+	return &robots.RobotResponse{}, f.record("CreateRobot %s", p.Attributes.Name)
+}
+
+func (f *fakeAPI) UpdateRobot(_ context.Context, id uuid.UUID, p *robots.RobotAttributes) (*robots.RobotResponse, error) {
+	return &robots.RobotResponse{}, f.record("UpdateRobot %s %s", id, p.Description)
+}
+
+func (f *fakeAPI) DeleteRobot(_ context.Context, id uuid.UUID) error {
+	return f.record("DeleteRobot %s", id)
+}
+
+func (f *fakeAPI) ListPermissions(_ context.Context, _ string, id uuid.UUID) ([]repositorypermission.Permission, error) {
+	if id == platformID {
+		return []repositorypermission.Permission{
+			{RepositoryName: "configs", Privilege: repositorypermission.PermissionRead},
+			{RepositoryName: "old", Privilege: repositorypermission.PermissionRead},
+		}, nil
+	}
+	return nil, nil
+}
+
+func (f *fakeAPI) SetPermission(_ context.Context, _ string, id uuid.UUID, p repositorypermission.CreatePermission) error {
+	return f.record("SetPermission %s %s %s", id, p.Repository, p.Permission.Permission)
+}
+
+func (f *fakeAPI) DeletePermission(_ context.Context, _ string, id uuid.UUID, p repositorypermission.PermissionIdentifier) error {
+	return f.record("DeletePermission %s %s", id, p.Repository)
+}
+
+const document = `
+organization: acme
+robots:
+- name: ci
+  description: builds
+teams:
+- name: platform
+  description: new
+  members: [alice]
+  robots: [ci]
+  repositories:
+    configs: write
+- name: security
+invites:
+- email: carol@example.com
+  permission: owner
+`
+
+func TestListRobots(t *testing.T) {
+	pages := [][]common.DataSet{{{ID: ciID}}, {{ID: newID}}, {}}
+	requests := 0
+	c := newClients(&up.Config{
+		Client: &fake.MockClient{
+			MockNewRequest: func(_ context.Context, method, prefix, _ string, _ interface{}) (*http.Request, error) {
+				return httptest.NewRequest(method, "/"+prefix, nil), nil
+			},
+			MockDo: func(_ *http.Request, obj interface{}) error {
+				obj.(*robots.RobotsResponse).DataSet = pages[requests]
+				requests++
+				return nil
+			},
+		},
+	})
+	rs, err := c.ListRobots(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]common.DataSet{{ID: ciID}, {ID: newID}}, rs); diff != "" {
+		t.Errorf("\nListRobots(...): robots on every page should be listed: -want, +got:\n%s", diff)
+	}
+}
+
+func TestParse(t *testing.T) {
+	type want struct {
+		teams int
+		err   error
+	}
+	cases := map[string]struct {
+		reason string
+		doc    string
+		want   want
+	}{
+		"Valid": {
+			reason: "A valid document should be parsed.",
+			doc:    document,
+			want:   want{teams: 2},
+		},
+		"NoOrganization": {
+			reason: "A document must name an organization.",
+			doc:    "teams: []",
+			want:   want{err: errors.New(errNoOrg)},
+		},
+		"DuplicateTeam": {
+			reason: "Team names must be unique.",
+			doc:    "organization: acme\nteams:\n- name: a\n- name: a",
+			want:   want{teams: 2, err: errors.Errorf(errDuplicateFmt, "team", "a")},
+		},
+		"UnknownRobot": {
+			reason: "Teams may only refer to managed robots.",
+			doc:    "organization: acme\nrobots: []\nteams:\n- name: a\n  robots: [ci]",
+			want:   want{teams: 1, err: errors.Errorf(errUnknownRobot, "a", "ci")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d, err := Parse([]byte(tc.doc))
+			got := want{err: err}
+			if d != nil {
+				got.teams = len(d.Teams)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nParse(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+
+	if _, err := Parse([]byte("organization: acme\nteam: []")); err == nil {
+		t.Errorf("\nParse(...): unknown fields should be rejected")
+	}
+}
+
+func TestPlan(t *testing.T) {
+	d, err := Parse([]byte(document))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := (&Applier{api: &fakeAPI{}}).Plan(context.Background(), d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		`update Team "platform": "old" -> "new"`,
+		`create TeamMember "platform/alice"`,
+		`delete TeamMember "platform/bob"`,
+		`create TeamRobot "platform/ci"`,
+		`update RepositoryPermission "platform/configs": "read" -> "write"`,
+		`delete RepositoryPermission "platform/old"`,
+		`create Team "security"`,
+		`update Invite "carol@example.com": "member" -> "owner"`,
+		`delete Invite "dave@example.com"`,
+		`delete Team "legacy"`,
+	}
+	got := make([]string, 0, len(p.Steps))
+	for _, s := range p.Steps {
+		got = append(got, s.String())
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\nPlan(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestApply(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		calls  []string
+		failed []string
+	}
+	cases := map[string]struct {
+		reason string
+		steps  []Step
+		err    map[string]error
+		opts   []ApplyOption
+		// noState applies the plan without the live state.
+		noState bool
+		want    want
+	}{
+		"Converge": {
+			reason: "Every step should be executed against the API.",
+			steps: []Step{
+				{Action: ActionCreate, Kind: KindTeam, Name: "security", To: "sec"},
+				{Action: ActionCreate, Kind: KindTeamMember, Team: "security", Name: "alice"},
+				{Action: ActionUpdate, Kind: KindRepositoryPermission, Team: "platform", Name: "configs", From: "read", To: "write"},
+				{Action: ActionUpdate, Kind: KindInvite, Name: "carol@example.com", From: "member", To: "owner"},
+				{Action: ActionDelete, Kind: KindRobot, Name: "ci"},
+			},
+			want: want{calls: []string{
+				"CreateTeam security",
+				"UpdateTeam " + newID.String() + " sec",
+				"AddTeamMember " + newID.String() + " 10",
+				"SetPermission " + platformID.String() + " configs write",
+				"DeleteInvite 20",
+				"CreateInvite carol@example.com owner",
+				"DeleteRobot " + ciID.String(),
+			}},
+		},
+		"StepFailed": {
+			reason: "A failed step should be reported, along with the steps that depend on it, without stopping the others.",
+			steps: []Step{
+				{Action: ActionCreate, Kind: KindTeam, Name: "security"},
+				{Action: ActionCreate, Kind: KindTeamMember, Team: "security", Name: "alice"},
+				{Action: ActionCreate, Kind: KindTeamMember, Team: "platform", Name: "mallory"},
+				{Action: ActionDelete, Kind: KindTeam, Name: "legacy"},
+			},
+			err: map[string]error{"CreateTeam %s": errBoom},
+			want: want{
+				calls: []string{"CreateTeam security", "DeleteTeam " + legacyID.String()},
+				failed: []string{
+					`create Team "security": boom`,
+					`create TeamMember "security/alice": team "security" does not exist`,
+					`create TeamMember "platform/mallory": user "mallory" is not a member of the organization`,
+				},
+			},
+		},
+		"NoState": {
+			reason:  "A plan without a live state should fail every step rather than act on unknown IDs.",
+			steps:   []Step{{Action: ActionDelete, Kind: KindTeam, Name: "legacy"}},
+			noState: true,
+			want:    want{failed: []string{`delete Team "legacy": ` + errNoState}},
+		},
+		"DryRun": {
+			reason: "A dry run should not execute any steps.",
+			steps:  []Step{{Action: ActionDelete, Kind: KindTeam, Name: "legacy"}},
+			opts:   []ApplyOption{WithDryRun()},
+			want:   want{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := &fakeAPI{err: tc.err}
+			a := &Applier{api: f}
+			d := &Document{Organization: "acme", Teams: []Team{}, Invites: []Invite{}}
+			s, err := load(context.Background(), f, d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.noState {
+				s = nil
+			}
+			r := a.Apply(context.Background(), &Plan{Organization: "acme", Steps: tc.steps, State: s}, tc.opts...)
+			got := want{calls: f.calls}
+			for _, res := range r.Failed() {
+				got.failed = append(got.failed, fmt.Sprintf("%s: %s", res.Step, res.Err))
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nApply(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			var msg string
+			if err := r.Err(); err != nil {
+				msg = err.Error()
+			}
+			if diff := cmp.Diff(strings.Join(tc.want.failed, "\n"), msg); diff != "" {
+				t.Errorf("\n%s\nApply(...).Err(): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			for _, err := range tc.err {
+				if !errors.Is(r.Err(), err) {
+					t.Errorf("\n%s\nApply(...).Err(): want an error matching %v", tc.reason, err)
+				}
+			}
+		})
+	}
+}
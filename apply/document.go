@@ -0,0 +1,154 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package apply converges the teams, robots, repository permissions and
+// invites of an Upbound organization to a desired state described in YAML.
+package apply
+
+import (
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/upbound/up-sdk-go/service/organizations"
+	"github.com/upbound/up-sdk-go/service/repositorypermission"
+)
+
+const (
+	errParse          = "cannot parse desired state"
+	errNoOrg          = "desired state must name an organization"
+	errDuplicateFmt   = "duplicate %s %q"
+	errNoPermission   = "invite for %q must set a permission"
+	errNoPrivilege    = "permission for repository %q of team %q must be set"
+	errUnknownRobot   = "team %q references robot %q, which is not in the desired state"
+	errTeamNameEmpty  = "every team must have a name"
+	errRobotNameEmpty = "every robot must have a name"
+)
+
+// A Document is the desired state of an organization.
+//
+// A section that is omitted is not managed: nothing of that kind is created,
+// updated or deleted. A section that is present but empty deletes everything
+// of that kind. The same applies to the members, robots and repositories of
+// each team.
+type Document struct {
+	// Organization is the name of the organization.
+	Organization string `json:"organization"`
+
+	Teams   []Team   `json:"teams,omitempty"`
+	Robots  []Robot  `json:"robots,omitempty"`
+	Invites []Invite `json:"invites,omitempty"`
+}
+
+// A Team is the desired state of a team.
+type Team struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+
+	// Members are the usernames of the organization members in the team.
+	Members []string `json:"members,omitempty"`
+
+	// Robots are the names of the robots in the team.
+	Robots []string `json:"robots,omitempty"`
+
+	// Repositories maps the name of each repository the team may access to
+	// its permission.
+	Repositories map[string]repositorypermission.PermissionType `json:"repositories,omitempty"`
+}
+
+// A Robot is the desired state of a robot.
+type Robot struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// An Invite is a pending invite to the organization.
+type Invite struct {
+	Email      string                                    `json:"email"`
+	Permission organizations.OrganizationPermissionGroup `json:"permission"`
+}
+
+// Parse parses and validates a YAML or JSON document. Unknown fields are
+// rejected.
+func Parse(data []byte) (*Document, error) {
+	d := &Document{}
+	if err := yaml.UnmarshalStrict(data, d); err != nil {
+		return nil, errors.Wrap(err, errParse)
+	}
+	return d, d.Validate()
+}
+
+// Validate returns an error if the document is incomplete or ambiguous.
+func (d *Document) Validate() error {
+	if d.Organization == "" {
+		return errors.New(errNoOrg)
+	}
+	robots := map[string]bool{}
+	for _, r := range d.Robots {
+		if r.Name == "" {
+			return errors.New(errRobotNameEmpty)
+		}
+		if robots[r.Name] {
+			return errors.Errorf(errDuplicateFmt, "robot", r.Name)
+		}
+		robots[r.Name] = true
+	}
+	teams := map[string]bool{}
+	for _, t := range d.Teams {
+		if t.Name == "" {
+			return errors.New(errTeamNameEmpty)
+		}
+		if teams[t.Name] {
+			return errors.Errorf(errDuplicateFmt, "team", t.Name)
+		}
+		teams[t.Name] = true
+		if err := unique("member of team "+t.Name, t.Members); err != nil {
+			return err
+		}
+		if err := unique("robot of team "+t.Name, t.Robots); err != nil {
+			return err
+		}
+		for _, r := range t.Robots {
+			if d.Robots != nil && !robots[r] {
+				return errors.Errorf(errUnknownRobot, t.Name, r)
+			}
+		}
+		for repo, p := range t.Repositories {
+			if p == "" {
+				return errors.Errorf(errNoPrivilege, repo, t.Name)
+			}
+		}
+	}
+	invites := map[string]bool{}
+	for _, i := range d.Invites {
+		if invites[i.Email] {
+			return errors.Errorf(errDuplicateFmt, "invite", i.Email)
+		}
+		invites[i.Email] = true
+		if i.Permission == "" {
+			return errors.Errorf(errNoPermission, i.Email)
+		}
+	}
+	return nil
+}
+
+func unique(kind string, names []string) error {
+	seen := map[string]bool{}
+	for _, n := range names {
+		if seen[n] {
+			return errors.Errorf(errDuplicateFmt, kind, n)
+		}
+		seen[n] = true
+	}
+	return nil
+}
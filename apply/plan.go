@@ -0,0 +1,208 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// An Action is what a step does to a resource.
+type Action string
+
+// Actions.
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// A Kind is the kind of resource a step acts on.
+type Kind string
+
+// Kinds.
+const (
+	KindTeam                 Kind = "Team"
+	KindTeamMember           Kind = "TeamMember"
+	KindTeamRobot            Kind = "TeamRobot"
+	KindRepositoryPermission Kind = "RepositoryPermission"
+	KindRobot                Kind = "Robot"
+	KindInvite               Kind = "Invite"
+)
+
+// A Step is a single change to the organization.
+type Step struct {
+	Action Action
+	Kind   Kind
+
+	// Team is the team a member, robot or repository permission belongs to.
+	Team string
+
+	// Name is the name of the resource, e.g. the name of a team or robot, the
+	// username of a member, the repository of a permission or the email of
+	// an invite.
+	Name string
+
+	// From and To are the live and desired values of the description of a
+	// team or robot, or of the permission of a repository or invite.
+	From string
+	To   string
+}
+
+// String describes the step.
+func (s Step) String() string {
+	name := s.Name
+	if s.Team != "" {
+		name = s.Team + "/" + s.Name
+	}
+	out := fmt.Sprintf("%s %s %q", s.Action, s.Kind, name)
+	if s.Action == ActionUpdate {
+		out += fmt.Sprintf(": %q -> %q", s.From, s.To)
+	}
+	return out
+}
+
+// A Plan is the ordered set of steps that converge an organization to its
+// desired state.
+type Plan struct {
+	Organization string
+	Steps        []Step
+
+	// State is the live state the plan was computed from. Steps refer to
+	// teams, robots, users and invites by name and are executed against the
+	// IDs in the state, so a plan without a state cannot be applied.
+	State *State
+}
+
+// diff computes the steps that converge the live state to the desired state.
+// Robots and teams are created first so that memberships can refer to them,
+// and deleted last.
+func diff(d *Document, s *State) []Step {
+	var steps []Step
+	add := func(st ...Step) { steps = append(steps, st...) }
+
+	desiredRobots := map[string]Robot{}
+	for _, r := range d.Robots {
+		desiredRobots[r.Name] = r
+	}
+	desiredTeams := map[string]Team{}
+	for _, t := range d.Teams {
+		desiredTeams[t.Name] = t
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(desiredRobots)) {
+		r := desiredRobots[name]
+		live, ok := s.Robots[name]
+		switch {
+		case !ok:
+			add(Step{Action: ActionCreate, Kind: KindRobot, Name: name, To: r.Description})
+		case live.Description != r.Description:
+			add(Step{Action: ActionUpdate, Kind: KindRobot, Name: name, From: live.Description, To: r.Description})
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(desiredTeams)) {
+		t := desiredTeams[name]
+		live, ok := s.Teams[name]
+		switch {
+		case !ok:
+			add(Step{Action: ActionCreate, Kind: KindTeam, Name: name, To: t.Description})
+			live = &TeamState{}
+		case live.Description != t.Description:
+			add(Step{Action: ActionUpdate, Kind: KindTeam, Name: name, From: live.Description, To: t.Description})
+		}
+		if t.Members != nil {
+			add(diffSet(KindTeamMember, name, t.Members, slices.Collect(maps.Keys(live.Members)))...)
+		}
+		if t.Robots != nil {
+			add(diffSet(KindTeamRobot, name, t.Robots, slices.Collect(maps.Keys(live.Robots)))...)
+		}
+		if t.Repositories != nil {
+			for _, repo := range slices.Sorted(maps.Keys(t.Repositories)) {
+				want := string(t.Repositories[repo])
+				got, ok := live.Repositories[repo]
+				switch {
+				case !ok:
+					add(Step{Action: ActionCreate, Kind: KindRepositoryPermission, Team: name, Name: repo, To: want})
+				case string(got) != want:
+					add(Step{Action: ActionUpdate, Kind: KindRepositoryPermission, Team: name, Name: repo, From: string(got), To: want})
+				}
+			}
+			for _, repo := range slices.Sorted(maps.Keys(live.Repositories)) {
+				if _, ok := t.Repositories[repo]; !ok {
+					add(Step{Action: ActionDelete, Kind: KindRepositoryPermission, Team: name, Name: repo, From: string(live.Repositories[repo])})
+				}
+			}
+		}
+	}
+
+	if d.Invites != nil {
+		desired := map[string]Invite{}
+		for _, i := range d.Invites {
+			desired[i.Email] = i
+		}
+		for _, email := range slices.Sorted(maps.Keys(desired)) {
+			want := string(desired[email].Permission)
+			live, ok := s.Invites[email]
+			switch {
+			case !ok:
+				add(Step{Action: ActionCreate, Kind: KindInvite, Name: email, To: want})
+			case string(live.Permission) != want:
+				add(Step{Action: ActionUpdate, Kind: KindInvite, Name: email, From: string(live.Permission), To: want})
+			}
+		}
+		for _, email := range slices.Sorted(maps.Keys(s.Invites)) {
+			if _, ok := desired[email]; !ok {
+				add(Step{Action: ActionDelete, Kind: KindInvite, Name: email, From: string(s.Invites[email].Permission)})
+			}
+		}
+	}
+
+	if d.Teams != nil {
+		for _, name := range slices.Sorted(maps.Keys(s.Teams)) {
+			if _, ok := desiredTeams[name]; !ok {
+				add(Step{Action: ActionDelete, Kind: KindTeam, Name: name, From: s.Teams[name].Description})
+			}
+		}
+	}
+	if d.Robots != nil {
+		for _, name := range slices.Sorted(maps.Keys(s.Robots)) {
+			if _, ok := desiredRobots[name]; !ok {
+				add(Step{Action: ActionDelete, Kind: KindRobot, Name: name, From: s.Robots[name].Description})
+			}
+		}
+	}
+	return steps
+}
+
+// diffSet returns the steps that add the desired names missing from live and
+// remove the live names that are not desired.
+func diffSet(k Kind, team string, desired, live []string) []Step {
+	var steps []Step
+	desired = slices.Sorted(slices.Values(desired))
+	live = slices.Sorted(slices.Values(live))
+	for _, n := range desired {
+		if !slices.Contains(live, n) {
+			steps = append(steps, Step{Action: ActionCreate, Kind: k, Team: team, Name: n})
+		}
+	}
+	for _, n := range live {
+		if !slices.Contains(desired, n) {
+			steps = append(steps, Step{Action: ActionDelete, Kind: k, Team: team, Name: n})
+		}
+	}
+	return steps
+}
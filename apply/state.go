@@ -0,0 +1,334 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/common"
+	"github.com/upbound/up-sdk-go/service/organizations"
+	"github.com/upbound/up-sdk-go/service/repositorypermission"
+	"github.com/upbound/up-sdk-go/service/robots"
+	"github.com/upbound/up-sdk-go/service/teams"
+)
+
+const (
+	errGetOrg        = "cannot get organization"
+	errListTeams     = "cannot list teams"
+	errListMembers   = "cannot list members of team %q"
+	errListRobots    = "cannot list robots of team %q"
+	errListPerms     = "cannot list repository permissions of team %q"
+	errListOrgRobots = "cannot list robots"
+	errListInvites   = "cannot list invites"
+	errListOrgUsers  = "cannot list organization members"
+	errDuplicateLive = "organization has more than one %s named %q"
+)
+
+// State is the live state of an organization.
+type State struct {
+	OrgID uint
+
+	// Teams are keyed by name.
+	Teams map[string]*TeamState
+
+	// Robots are keyed by name.
+	Robots map[string]RobotState
+
+	// Invites are keyed by email.
+	Invites map[string]organizations.Invite
+
+	// Users maps the username of every organization member to its ID.
+	Users map[string]uint
+}
+
+// TeamState is the live state of a team.
+type TeamState struct {
+	ID          uuid.UUID
+	Description string
+
+	// Members maps the username of each member to its ID.
+	Members map[string]uint
+
+	// Robots maps the name of each robot to its ID.
+	Robots map[string]uuid.UUID
+
+	// Repositories maps each repository to the team's permission.
+	Repositories map[string]repositorypermission.PermissionType
+}
+
+// RobotState is the live state of a robot.
+type RobotState struct {
+	ID          uuid.UUID
+	Description string
+}
+
+// api is the subset of the Upbound API used to read and converge an
+// organization.
+type api interface {
+	GetOrgID(ctx context.Context, name string) (uint, error)
+	ListOrgMembers(ctx context.Context, orgID uint) ([]organizations.Member, error)
+	ListInvites(ctx context.Context, orgID uint) ([]organizations.Invite, error)
+	CreateInvite(ctx context.Context, orgID uint, params *organizations.OrganizationInviteCreateParameters) error
+	DeleteInvite(ctx context.Context, orgID uint, inviteID uint) error
+
+	ListTeams(ctx context.Context, orgID uint) ([]teams.TeamResponse, error)
+	CreateTeam(ctx context.Context, params *teams.TeamCreateParameters) (*teams.TeamResponse, error)
+	UpdateTeam(ctx context.Context, id uuid.UUID, params *teams.TeamAttributes) (*teams.TeamResponse, error)
+	DeleteTeam(ctx context.Context, id uuid.UUID) error
+	ListTeamMembers(ctx context.Context, id uuid.UUID) ([]teams.TeamMember, error)
+	AddTeamMember(ctx context.Context, id uuid.UUID, params *teams.TeamMemberParameters) error
+	RemoveTeamMember(ctx context.Context, id uuid.UUID, userID uint) error
+	ListTeamRobots(ctx context.Context, id uuid.UUID) ([]teams.TeamRobot, error)
+	AddTeamRobot(ctx context.Context, id, robotID uuid.UUID) error
+	RemoveTeamRobot(ctx context.Context, id, robotID uuid.UUID) error
+
+	ListRobots(ctx context.Context, orgID uint) ([]common.DataSet, error)
+	CreateRobot(ctx context.Context, params *robots.RobotCreateParameters) (*robots.RobotResponse, error)
+	UpdateRobot(ctx context.Context, id uuid.UUID, params *robots.RobotAttributes) (*robots.RobotResponse, error)
+	DeleteRobot(ctx context.Context, id uuid.UUID) error
+
+	ListPermissions(ctx context.Context, org string, teamID uuid.UUID) ([]repositorypermission.Permission, error)
+	SetPermission(ctx context.Context, org string, teamID uuid.UUID, params repositorypermission.CreatePermission) error
+	DeletePermission(ctx context.Context, org string, teamID uuid.UUID, params repositorypermission.PermissionIdentifier) error
+}
+
+// clients implements api with the Upbound service clients.
+type clients struct {
+	orgs   *organizations.Client
+	teams  *teams.Client
+	robots *robots.Client
+	perms  *repositorypermission.Client
+}
+
+func newClients(cfg *up.Config) *clients {
+	return &clients{
+		orgs:   organizations.NewClient(cfg),
+		teams:  teams.NewClient(cfg),
+		robots: robots.NewClient(cfg),
+		perms:  repositorypermission.NewClient(cfg),
+	}
+}
+
+func (c *clients) GetOrgID(ctx context.Context, name string) (uint, error) {
+	return c.orgs.GetOrgID(ctx, name)
+}
+
+func (c *clients) ListOrgMembers(ctx context.Context, orgID uint) ([]organizations.Member, error) {
+	return c.orgs.ListMembers(ctx, orgID)
+}
+
+func (c *clients) ListInvites(ctx context.Context, orgID uint) ([]organizations.Invite, error) {
+	return c.orgs.ListInvites(ctx, orgID)
+}
+
+func (c *clients) CreateInvite(ctx context.Context, orgID uint, params *organizations.OrganizationInviteCreateParameters) error {
+	return c.orgs.CreateInvite(ctx, orgID, params)
+}
+
+func (c *clients) DeleteInvite(ctx context.Context, orgID uint, inviteID uint) error {
+	return c.orgs.DeleteInvite(ctx, orgID, inviteID)
+}
+
+func (c *clients) ListTeams(ctx context.Context, orgID uint) ([]teams.TeamResponse, error) {
+	var ts []teams.TeamResponse
+	for t, err := range c.teams.ListAll(ctx, orgID) {
+		if err != nil {
+			return nil, err
+		}
+		ts = append(ts, t)
+	}
+	return ts, nil
+}
+
+func (c *clients) CreateTeam(ctx context.Context, params *teams.TeamCreateParameters) (*teams.TeamResponse, error) {
+	return c.teams.Create(ctx, params)
+}
+
+func (c *clients) UpdateTeam(ctx context.Context, id uuid.UUID, params *teams.TeamAttributes) (*teams.TeamResponse, error) {
+	return c.teams.Update(ctx, id, params)
+}
+
+func (c *clients) DeleteTeam(ctx context.Context, id uuid.UUID) error {
+	return c.teams.Delete(ctx, id)
+}
+
+func (c *clients) ListTeamMembers(ctx context.Context, id uuid.UUID) ([]teams.TeamMember, error) {
+	return c.teams.ListMembers(ctx, id)
+}
+
+func (c *clients) AddTeamMember(ctx context.Context, id uuid.UUID, params *teams.TeamMemberParameters) error {
+	return c.teams.AddMember(ctx, id, params)
+}
+
+func (c *clients) RemoveTeamMember(ctx context.Context, id uuid.UUID, userID uint) error {
+	return c.teams.RemoveMember(ctx, id, userID)
+}
+
+func (c *clients) ListTeamRobots(ctx context.Context, id uuid.UUID) ([]teams.TeamRobot, error) {
+	return c.teams.ListRobots(ctx, id)
+}
+
+func (c *clients) AddTeamRobot(ctx context.Context, id, robotID uuid.UUID) error {
+	return c.teams.AddRobot(ctx, id, robotID)
+}
+
+func (c *clients) RemoveTeamRobot(ctx context.Context, id, robotID uuid.UUID) error {
+	return c.teams.RemoveRobot(ctx, id, robotID)
+}
+
+func (c *clients) ListRobots(ctx context.Context, orgID uint) ([]common.DataSet, error) {
+	var rs []common.DataSet
+	for r, err := range c.robots.ListAll(ctx, orgID) {
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+func (c *clients) CreateRobot(ctx context.Context, params *robots.RobotCreateParameters) (*robots.RobotResponse, error) {
+	return c.robots.Create(ctx, params)
+}
+
+func (c *clients) UpdateRobot(ctx context.Context, id uuid.UUID, params *robots.RobotAttributes) (*robots.RobotResponse, error) {
+	return c.robots.Update(ctx, id, params)
+}
+
+func (c *clients) DeleteRobot(ctx context.Context, id uuid.UUID) error {
+	return c.robots.Delete(ctx, id)
+}
+
+func (c *clients) ListPermissions(ctx context.Context, org string, teamID uuid.UUID) ([]repositorypermission.Permission, error) {
+	var ps []repositorypermission.Permission
+	for p, err := range c.perms.ListAll(ctx, org, teamID) {
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, p)
+	}
+	return ps, nil
+}
+
+func (c *clients) SetPermission(ctx context.Context, org string, teamID uuid.UUID, params repositorypermission.CreatePermission) error {
+	return c.perms.Create(ctx, org, teamID, params)
+}
+
+func (c *clients) DeletePermission(ctx context.Context, org string, teamID uuid.UUID, params repositorypermission.PermissionIdentifier) error {
+	return c.perms.Delete(ctx, org, teamID, params)
+}
+
+// load reads the live state of the organization. Only the sections managed by
+// the desired state are read.
+func load(ctx context.Context, a api, d *Document) (*State, error) {
+	id, err := a.GetOrgID(ctx, d.Organization)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetOrg)
+	}
+	s := &State{
+		OrgID:   id,
+		Teams:   map[string]*TeamState{},
+		Robots:  map[string]RobotState{},
+		Invites: map[string]organizations.Invite{},
+		Users:   map[string]uint{},
+	}
+
+	if d.Robots != nil || d.Teams != nil {
+		rs, err := a.ListRobots(ctx, id)
+		if err != nil {
+			return nil, errors.Wrap(err, errListOrgRobots)
+		}
+		for _, r := range rs {
+			name, _ := r.AttributeSet["name"].(string)
+			desc, _ := r.AttributeSet["description"].(string)
+			if _, ok := s.Robots[name]; ok {
+				return nil, errors.Errorf(errDuplicateLive, "robot", name)
+			}
+			s.Robots[name] = RobotState{ID: r.ID, Description: desc}
+		}
+	}
+
+	if d.Invites != nil {
+		is, err := a.ListInvites(ctx, id)
+		if err != nil {
+			return nil, errors.Wrap(err, errListInvites)
+		}
+		for _, i := range is {
+			s.Invites[i.Email] = i
+		}
+	}
+
+	if d.Teams == nil {
+		return s, nil
+	}
+	ms, err := a.ListOrgMembers(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, errListOrgUsers)
+	}
+	for _, m := range ms {
+		s.Users[m.User.Username] = m.User.ID
+	}
+	ts, err := a.ListTeams(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, errListTeams)
+	}
+	for _, t := range ts {
+		if _, ok := s.Teams[t.Name]; ok {
+			return nil, errors.Errorf(errDuplicateLive, "team", t.Name)
+		}
+		ls, err := loadTeam(ctx, a, d.Organization, t)
+		if err != nil {
+			return nil, err
+		}
+		s.Teams[t.Name] = ls
+	}
+	return s, nil
+}
+
+func loadTeam(ctx context.Context, a api, org string, t teams.TeamResponse) (*TeamState, error) {
+	s := &TeamState{
+		ID:           t.ID,
+		Description:  t.Description,
+		Members:      map[string]uint{},
+		Robots:       map[string]uuid.UUID{},
+		Repositories: map[string]repositorypermission.PermissionType{},
+	}
+	ms, err := a.ListTeamMembers(ctx, t.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, errListMembers, t.Name)
+	}
+	for _, m := range ms {
+		s.Members[m.Username] = m.ID
+	}
+	rs, err := a.ListTeamRobots(ctx, t.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, errListRobots, t.Name)
+	}
+	for _, r := range rs {
+		s.Robots[r.Name] = r.ID
+	}
+	ps, err := a.ListPermissions(ctx, org, t.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, errListPerms, t.Name)
+	}
+	for _, p := range ps {
+		s.Repositories[p.RepositoryName] = p.Privilege
+	}
+	return s, nil
+}
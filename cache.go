@@ -0,0 +1,478 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultCacheSize is the number of responses kept by a
+	// MemoryCacheStore by default.
+	DefaultCacheSize = 256
+
+	cacheControlHeader    = "Cache-Control"
+	etagHeader            = "ETag"
+	lastModifiedHeader    = "Last-Modified"
+	ifNoneMatchHeader     = "If-None-Match"
+	ifModifiedSinceHeader = "If-Modified-Since"
+	varyHeader            = "Vary"
+)
+
+// sensitiveHeaders are never stored by a Cache, so that credentials are
+// neither persisted nor replayed to later requests.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Proxy-Authorization", "Set-Cookie"} //nolint:gochecknoglobals // This is intended to be global.
+
+// WithCache sets the Cache used by the client. Responses are not cached when
+// it is nil.
+func WithCache(c *Cache) ClientModifierFn {
+	return func(hc *HTTPClient) {
+		hc.Cache = c
+	}
+}
+
+// A CachedResponse is a response stored in a CacheStore.
+type CachedResponse struct {
+	// StatusCode is the status code of the response.
+	StatusCode int `json:"statusCode"`
+
+	// Header is the header of the response, including the validators used
+	// to revalidate it.
+	Header http.Header `json:"header"`
+
+	// Body is the body of the response.
+	Body []byte `json:"body"`
+
+	// Expires is the time after which the response must be revalidated
+	// before it is used.
+	Expires time.Time `json:"expires"`
+
+	// Variant is a hash of the request headers named by the Vary header of
+	// the response. The response is only served to requests with the same
+	// values for those headers.
+	Variant string `json:"variant,omitempty"`
+}
+
+// A CacheStore stores cached responses by key. Implementations must be safe
+// for concurrent use. A store that cannot read or write a response should
+// treat it as a miss rather than fail the request.
+type CacheStore interface {
+	Get(key string) (*CachedResponse, bool)
+	Set(key string, res *CachedResponse)
+	Delete(key string)
+}
+
+// A CacheOption modifies a Cache.
+type CacheOption func(*Cache)
+
+// WithCacheClock sets the function used to tell the current time.
+func WithCacheClock(now func() time.Time) CacheOption {
+	return func(c *Cache) {
+		c.now = now
+	}
+}
+
+// A Cache stores GET responses in a CacheStore and serves them while they are
+// fresh according to their Cache-Control header. Stale responses carrying an
+// ETag or Last-Modified header are revalidated with a conditional request and
+// served from the store if the server responds 304 Not Modified.
+//
+// Responses are keyed by URL and by a hash of the credentials of the request,
+// so clients that authenticate as different users may share a Cache without
+// serving each other's responses. They are only served to requests that match
+// the request headers named by their Vary header. Headers that set or carry
+// credentials, like Set-Cookie, are never stored.
+type Cache struct {
+	store CacheStore
+	now   func() time.Time
+}
+
+// NewCache builds a Cache backed by the supplied store.
+func NewCache(store CacheStore, opts ...CacheOption) *Cache {
+	c := &Cache{store: store, now: time.Now}
+	for _, o := range opts {
+		o(c)
+	}
+	return c
+}
+
+type contextNoCacheType struct{}
+
+var contextNoCacheKey = &contextNoCacheType{} //nolint:gochecknoglobals // This is intended to be global.
+
+// WithoutCache returns a context that bypasses the client's Cache. Requests
+// made with it are always sent to the server, and their responses are not
+// stored.
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextNoCacheKey, true)
+}
+
+func cacheBypassed(ctx context.Context) bool {
+	b, _ := ctx.Value(contextNoCacheKey).(bool)
+	return b
+}
+
+// doCached serves the request from the client's Cache if possible, and sends
+// it otherwise.
+func (c *HTTPClient) doCached(req *http.Request) (*http.Response, error) {
+	if c.Cache == nil || cacheBypassed(req.Context()) {
+		return c.do(req)
+	}
+	key, err := c.cacheKey(req)
+	if err != nil {
+		// The request cannot be authenticated, so sending it reports why.
+		return c.do(req)
+	}
+	switch req.Method {
+	case http.MethodGet:
+		if _, ok := parseCacheControl(req.Header)["no-store"]; ok {
+			return c.do(req)
+		}
+		return c.Cache.roundTrip(req, key, c.do)
+	case http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return c.do(req)
+	}
+	res, err := c.do(req)
+	// A successful unsafe request invalidates any response cached for its
+	// URL and credentials.
+	if err == nil && res.StatusCode < http.StatusBadRequest {
+		c.Cache.store.Delete(key)
+	}
+	return res, err
+}
+
+// roundTrip serves a GET request from the store, revalidating or refreshing
+// the stored response with send as necessary.
+func (c *Cache) roundTrip(req *http.Request, key string, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	now := c.now()
+	cached, ok := c.store.Get(key)
+	// A response that varies on request headers is only used for requests
+	// that match it. A new response replaces it otherwise.
+	ok = ok && cached.Variant == variant(req, cached.Header)
+	orig := req
+	if ok {
+		_, noCache := parseCacheControl(req.Header)["no-cache"]
+		if !noCache && now.Before(cached.Expires) {
+			return cached.response(req), nil
+		}
+		if etag := cached.Header.Get(etagHeader); etag != "" || cached.Header.Get(lastModifiedHeader) != "" {
+			req = req.Clone(req.Context())
+			if etag != "" {
+				req.Header.Set(ifNoneMatchHeader, etag)
+			}
+			if lm := cached.Header.Get(lastModifiedHeader); lm != "" {
+				req.Header.Set(ifModifiedSinceHeader, lm)
+			}
+		}
+	}
+	res, err := send(req)
+	if err != nil {
+		return nil, err
+	}
+	if ok && res.StatusCode == http.StatusNotModified {
+		drain(res)
+		// The 304 carries the current caching headers of the response.
+		for k, v := range storedHeader(res.Header) {
+			cached.Header[k] = v
+		}
+		cached.Expires = expires(cached.Header, c.now())
+		cached.Variant = variant(orig, cached.Header)
+		c.store.Set(key, cached)
+		return cached.response(req), nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return res, nil
+	}
+	if !storable(res.Header) {
+		c.store.Delete(key)
+		return res, nil
+	}
+	b, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(b))
+	c.store.Set(key, &CachedResponse{
+		StatusCode: res.StatusCode,
+		Header:     storedHeader(res.Header),
+		Body:       b,
+		Expires:    expires(res.Header, c.now()),
+		Variant:    variant(orig, res.Header),
+	})
+	return res, nil
+}
+
+// response builds an HTTP response for req from the cached response.
+func (r *CachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+// storable returns true if a response with the supplied header may be stored.
+// Responses are only stored if they are fresh for some time or can be
+// revalidated.
+func storable(h http.Header) bool {
+	cc := parseCacheControl(h)
+	if _, ok := cc["no-store"]; ok {
+		return false
+	}
+	if h.Get(varyHeader) == "*" {
+		return false
+	}
+	if h.Get(etagHeader) != "" || h.Get(lastModifiedHeader) != "" {
+		return true
+	}
+	_, noCache := cc["no-cache"]
+	return !noCache && maxAge(cc) > 0
+}
+
+// storedHeader returns a copy of the header without sensitive headers.
+func storedHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, k := range sensitiveHeaders {
+		h.Del(k)
+	}
+	return h
+}
+
+// variant returns a hash of the values of the request headers named by the
+// Vary header of a response, or an empty string if it varies on none. The
+// values are hashed so that credentials are never stored.
+func variant(req *http.Request, h http.Header) string {
+	var names []string
+	for _, v := range h.Values(varyHeader) {
+		for _, n := range strings.Split(v, ",") {
+			if n = strings.TrimSpace(n); n != "" {
+				names = append(names, http.CanonicalHeaderKey(n))
+			}
+		}
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	slices.Sort(names)
+	sum := sha256.New()
+	for _, n := range slices.Compact(names) {
+		fmt.Fprintf(sum, "%s: %q\n", n, req.Header.Values(n))
+	}
+	return hex.EncodeToString(sum.Sum(nil))
+}
+
+// expires returns the time until which a response with the supplied header is
+// fresh. Responses without a max-age directive must be revalidated before
+// every use.
+func expires(h http.Header, now time.Time) time.Time {
+	cc := parseCacheControl(h)
+	if _, ok := cc["no-cache"]; ok {
+		return now
+	}
+	return now.Add(maxAge(cc))
+}
+
+func maxAge(cc map[string]string) time.Duration {
+	s, err := strconv.Atoi(cc["max-age"])
+	if err != nil || s < 0 {
+		return 0
+	}
+	return time.Duration(s) * time.Second
+}
+
+// parseCacheControl parses the directives of a Cache-Control header. Directives
+// without a value map to an empty string.
+func parseCacheControl(h http.Header) map[string]string {
+	cc := map[string]string{}
+	for _, v := range h.Values(cacheControlHeader) {
+		for _, d := range strings.Split(v, ",") {
+			k, val, _ := strings.Cut(strings.TrimSpace(d), "=")
+			if k == "" {
+				continue
+			}
+			cc[strings.ToLower(k)] = strings.Trim(val, `"`)
+		}
+	}
+	return cc
+}
+
+// cacheKey returns the key of the response cached for req: a hash of the
+// credentials the request is sent with, followed by its URL. The credentials
+// are those of the request itself and of the client's CredentialProvider, if
+// any. They are hashed so that they are never stored.
+func (c *HTTPClient) cacheKey(req *http.Request) (string, error) {
+	r := req.Clone(req.Context())
+	if c.HTTP != nil {
+		if ct, ok := c.HTTP.Transport.(*ContextTransport); ok && ct.credentials != nil {
+			if err := ct.credentials.Authenticate(r); err != nil {
+				return "", err
+			}
+		}
+	}
+	sum := sha256.New()
+	for _, k := range sensitiveHeaders {
+		fmt.Fprintf(sum, "%s: %q\n", k, r.Header.Values(k))
+	}
+	return hex.EncodeToString(sum.Sum(nil)) + " " + req.URL.String(), nil
+}
+
+var _ CacheStore = &MemoryCacheStore{}
+
+// A MemoryCacheStore keeps a fixed number of responses in memory, evicting the
+// least recently used response when it is full.
+type MemoryCacheStore struct {
+	size int
+
+	mu      sync.Mutex
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type memoryEntry struct {
+	key string
+	res *CachedResponse
+}
+
+// NewMemoryCacheStore builds a MemoryCacheStore that holds up to size
+// responses. DefaultCacheSize is used if size is not positive.
+func NewMemoryCacheStore(size int) *MemoryCacheStore {
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+	return &MemoryCacheStore{size: size, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+// Get returns the response stored for key.
+func (s *MemoryCacheStore) Get(key string) (*CachedResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	s.order.MoveToFront(e)
+	res := *e.Value.(*memoryEntry).res //nolint:forcetypeassert // Always a *memoryEntry.
+	res.Header = res.Header.Clone()
+	return &res, true
+}
+
+// Set stores the response for key, evicting the least recently used response
+// if the store is full.
+func (s *MemoryCacheStore) Set(key string, res *CachedResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.entries[key]; ok {
+		e.Value.(*memoryEntry).res = res //nolint:forcetypeassert // Always a *memoryEntry.
+		s.order.MoveToFront(e)
+		return
+	}
+	s.entries[key] = s.order.PushFront(&memoryEntry{key: key, res: res})
+	for s.order.Len() > s.size {
+		e := s.order.Back()
+		s.order.Remove(e)
+		delete(s.entries, e.Value.(*memoryEntry).key) //nolint:forcetypeassert // Always a *memoryEntry.
+	}
+}
+
+// Delete removes the response stored for key.
+func (s *MemoryCacheStore) Delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.entries[key]; ok {
+		s.order.Remove(e)
+		delete(s.entries, key)
+	}
+}
+
+var _ CacheStore = &DiskCacheStore{}
+
+// A DiskCacheStore keeps responses as files in a directory, so that they
+// survive restarts. Files that cannot be read or written are treated as
+// misses.
+type DiskCacheStore struct {
+	dir string
+}
+
+// NewDiskCacheStore builds a DiskCacheStore that keeps responses in dir. The
+// directory is created if it does not exist.
+func NewDiskCacheStore(dir string) (*DiskCacheStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &DiskCacheStore{dir: dir}, nil
+}
+
+// Get returns the response stored for key.
+func (s *DiskCacheStore) Get(key string) (*CachedResponse, bool) {
+	b, err := os.ReadFile(s.path(key))
+	if err != nil {
+		return nil, false
+	}
+	res := &CachedResponse{}
+	if err := json.Unmarshal(b, res); err != nil {
+		return nil, false
+	}
+	return res, true
+}
+
+// Set stores the response for key. The file is written atomically so that
+// concurrent readers never observe a partial response.
+func (s *DiskCacheStore) Set(key string, res *CachedResponse) {
+	b, err := json.Marshal(res)
+	if err != nil {
+		return
+	}
+	f, err := os.CreateTemp(s.dir, ".tmp-")
+	if err != nil {
+		return
+	}
+	_, werr := f.Write(b)
+	cerr := f.Close()
+	if werr != nil || cerr != nil || os.Rename(f.Name(), s.path(key)) != nil {
+		_ = os.Remove(f.Name())
+	}
+}
+
+// Delete removes the response stored for key.
+func (s *DiskCacheStore) Delete(key string) {
+	_ = os.Remove(s.path(key))
+}
+
+func (s *DiskCacheStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:]))
+}
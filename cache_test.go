@@ -0,0 +1,289 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDoCache(t *testing.T) {
+	type call struct {
+		after  time.Duration
+		method string
+		bypass bool
+		accept string
+	}
+	type want struct {
+		bodies      []string
+		sent        int
+		conditional []string
+	}
+	cases := map[string]struct {
+		reason string
+		header http.Header
+		calls  []call
+		want   want
+	}{
+		"Fresh": {
+			reason: "A response should be served from the cache while its max-age has not elapsed.",
+			header: http.Header{"Cache-Control": {"max-age=60"}},
+			calls:  []call{{}, {after: 30 * time.Second}},
+			want:   want{bodies: []string{"1", "1"}, sent: 1, conditional: []string{""}},
+		},
+		"Expired": {
+			reason: "A response without validators should be fetched again once its max-age has elapsed.",
+			header: http.Header{"Cache-Control": {"max-age=60"}},
+			calls:  []call{{}, {after: 61 * time.Second}},
+			want:   want{bodies: []string{"1", "2"}, sent: 2, conditional: []string{"", ""}},
+		},
+		"RevalidatedETag": {
+			reason: "A stale response with an ETag should be revalidated and served from the cache on 304.",
+			header: http.Header{"Etag": {`"v1"`}},
+			calls:  []call{{}, {}},
+			want:   want{bodies: []string{"1", "1"}, sent: 2, conditional: []string{"", `"v1"`}},
+		},
+		"RevalidatedLastModified": {
+			reason: "A stale response with a Last-Modified header should be revalidated and served from the cache on 304.",
+			header: http.Header{"Last-Modified": {"Wed, 01 Jan 2025 00:00:00 GMT"}},
+			calls:  []call{{}, {}},
+			want:   want{bodies: []string{"1", "1"}, sent: 2, conditional: []string{"", "Wed, 01 Jan 2025 00:00:00 GMT"}},
+		},
+		"NoStore": {
+			reason: "A response marked no-store should never be cached.",
+			header: http.Header{"Cache-Control": {"no-store"}, "Etag": {`"v1"`}},
+			calls:  []call{{}, {}},
+			want:   want{bodies: []string{"1", "2"}, sent: 2, conditional: []string{"", ""}},
+		},
+		"Bypass": {
+			reason: "A request made with WithoutCache should always be sent to the server.",
+			header: http.Header{"Cache-Control": {"max-age=60"}},
+			calls:  []call{{}, {bypass: true}, {}},
+			want:   want{bodies: []string{"1", "2", "1"}, sent: 2, conditional: []string{"", ""}},
+		},
+		"Vary": {
+			reason: "A response should only be served to requests that match the request headers it varies on.",
+			header: http.Header{"Cache-Control": {"max-age=60"}, "Vary": {"Accept"}},
+			calls:  []call{{accept: "a"}, {accept: "b"}, {accept: "b"}},
+			want:   want{bodies: []string{"1", "2", "2"}, sent: 2, conditional: []string{"", ""}},
+		},
+		"Invalidated": {
+			reason: "A successful PUT should invalidate the response cached for its URL.",
+			header: http.Header{"Cache-Control": {"max-age=60"}},
+			calls:  []call{{}, {method: http.MethodPut}, {}},
+			want:   want{bodies: []string{"1", "", "3"}, sent: 3, conditional: []string{"", "", ""}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got want
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got.sent++
+				cond := r.Header.Get(ifNoneMatchHeader) + r.Header.Get(ifModifiedSinceHeader)
+				got.conditional = append(got.conditional, cond)
+				for k, v := range tc.header {
+					w.Header()[k] = v
+				}
+				if cond != "" {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				if r.Method == http.MethodPut {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				fmt.Fprintf(w, "%d", got.sent)
+			}))
+			defer s.Close()
+			u, _ := url.Parse(s.URL)
+			now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			c := NewClient(func(c *HTTPClient) {
+				c.BaseURL = u
+			}, WithCache(NewCache(NewMemoryCacheStore(0), WithCacheClock(func() time.Time { return now }))))
+			for _, call := range tc.calls {
+				now = now.Add(call.after)
+				ctx := context.Background()
+				if call.bypass {
+					ctx = WithoutCache(ctx)
+				}
+				method := http.MethodGet
+				if call.method != "" {
+					method = call.method
+				}
+				req, err := c.NewRequest(ctx, method, "v1", "test", nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if call.accept != "" {
+					req.Header.Set("Accept", call.accept)
+				}
+				var body int
+				if method == http.MethodGet {
+					if err := c.Do(req, &body); err != nil {
+						t.Fatal(err)
+					}
+					got.bodies = append(got.bodies, fmt.Sprint(body))
+					continue
+				}
+				if err := c.Do(req, nil); err != nil {
+					t.Fatal(err)
+				}
+				got.bodies = append(got.bodies, "")
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nDo(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestMemoryCacheStoreEviction(t *testing.T) {
+	s := NewMemoryCacheStore(2)
+	s.Set("a", &CachedResponse{Body: []byte("a")})
+	s.Set("b", &CachedResponse{Body: []byte("b")})
+	s.Get("a")
+	s.Set("c", &CachedResponse{Body: []byte("c")})
+
+	got := map[string]bool{}
+	for _, k := range []string{"a", "b", "c"} {
+		_, got[k] = s.Get(k)
+	}
+	if diff := cmp.Diff(map[string]bool{"a": true, "b": false, "c": true}, got); diff != "" {
+		t.Errorf("\nSet(...): the least recently used response should be evicted: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDiskCacheStore(t *testing.T) {
+	s, err := NewDiskCacheStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &CachedResponse{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Etag": {`"v1"`}},
+		Body:       []byte(`{"a":"b"}`),
+		Expires:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	s.Set("https://api.upbound.io/v1/test", want)
+	got, ok := s.Get("https://api.upbound.io/v1/test")
+	if !ok {
+		t.Fatal("\nGet(...): the stored response should be found")
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\nGet(...): -want, +got:\n%s", diff)
+	}
+	s.Delete("https://api.upbound.io/v1/test")
+	if _, ok := s.Get("https://api.upbound.io/v1/test"); ok {
+		t.Errorf("\nDelete(...): the response should be removed")
+	}
+}
+
+func TestCacheCredentials(t *testing.T) {
+	sent := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent++
+		w.Header().Set("Cache-Control", "max-age=60")
+		fmt.Fprintf(w, "%q", r.Header.Get("Authorization"))
+	}))
+	defer s.Close()
+	u, _ := url.Parse(s.URL)
+	c := NewClient(func(c *HTTPClient) {
+		c.BaseURL = u
+	}, WithCache(NewCache(NewMemoryCacheStore(0))))
+	alice := c.With(WithCredentials(&StaticTokenProvider{Token: "alice"}))
+	bob := c.With(WithCredentials(&StaticTokenProvider{Token: "bob"}))
+
+	var got []string
+	for _, hc := range []Client{alice, bob, alice, bob} {
+		req, err := hc.NewRequest(context.Background(), http.MethodGet, "v1", "test", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var body string
+		if err := hc.Do(req, &body); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, body)
+	}
+	if diff := cmp.Diff([]string{"Bearer alice", "Bearer bob", "Bearer alice", "Bearer bob"}, got); diff != "" {
+		t.Errorf("\nDo(...): clients with different credentials should not share responses: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(2, sent); diff != "" {
+		t.Errorf("\nDo(...): responses should be cached per credentials: -want sent, +got sent:\n%s", diff)
+	}
+}
+
+func TestCacheSensitiveHeaders(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=60")
+		w.Header().Set("Set-Cookie", "session=secret")
+		fmt.Fprint(w, "1")
+	}))
+	defer s.Close()
+	u, _ := url.Parse(s.URL)
+	dir := t.TempDir()
+	store, err := NewDiskCacheStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewClient(func(c *HTTPClient) {
+		c.BaseURL = u
+	}, WithCache(NewCache(store)))
+
+	for i := range 2 {
+		req, err := c.NewRequest(context.Background(), http.MethodGet, "v1", "test", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		key, err := c.cacheKey(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := c.Cache.roundTrip(req, key, c.do)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = res.Body.Close()
+		// The first response comes from the server, the second from the
+		// store.
+		if got := res.Header.Get("Set-Cookie"); (got != "") != (i == 0) {
+			t.Errorf("\nroundTrip(...): response %d has Set-Cookie %q", i, got)
+		}
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		b, err := os.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(b), "secret") {
+			t.Errorf("\nSet(...): the stored response should not contain the cookie:\n%s", b)
+		}
+	}
+}
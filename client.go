@@ -26,6 +26,10 @@ import (
 	"time"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 
 	uerrors "github.com/upbound/up-sdk-go/errors"
 	"github.com/upbound/up-sdk-go/http/headers"
@@ -36,6 +40,8 @@ const (
 	defaultBaseURL     = "https://api.upbound.io"
 	defaultUserAgent   = "up-sdk-go"
 	defaultHTTPTimeout = 10 * time.Second
+
+	errAuthenticate = "cannot authenticate request"
 )
 
 // Client is an HTTP client for communicating with Upbound.
@@ -45,9 +51,39 @@ type Client interface {
 	With(modifiers ...ClientModifierFn) Client
 }
 
+// A Streamer streams the bodies of responses rather than decoding them, e.g.
+// to follow a watch.
+type Streamer interface {
+	Stream(req *http.Request) (io.ReadCloser, error)
+}
+
 // A ClientModifierFn modifies an HTTP client.
 type ClientModifierFn func(*HTTPClient)
 
+// WithCredentials authenticates every request sent by the client with the
+// supplied CredentialProvider. The provider is injected into the client's
+// ContextTransport, which wraps the existing transport if necessary.
+func WithCredentials(p CredentialProvider) ClientModifierFn {
+	return func(c *HTTPClient) {
+		hc := &http.Client{}
+		if c.HTTP != nil {
+			*hc = *c.HTTP
+		}
+		if ct, ok := hc.Transport.(*ContextTransport); ok {
+			nct := *ct
+			nct.credentials = p
+			hc.Transport = &nct
+		} else {
+			t := hc.Transport
+			if t == nil {
+				t = http.DefaultTransport
+			}
+			hc.Transport = NewContextTransport(WithTransport(t), WithCredentialProvider(p))
+		}
+		c.HTTP = hc
+	}
+}
+
 // NewClient builds a new default HTTP client for Upbound.
 func NewClient(modifiers ...ClientModifierFn) *HTTPClient {
 	b, _ := url.Parse(defaultBaseURL)
@@ -80,6 +116,27 @@ type HTTPClient struct {
 
 	// User agent for communicating with the Upbound API.
 	UserAgent string
+
+	// RetryPolicy decides whether failed requests are attempted again.
+	// Requests are only attempted once if it is nil.
+	RetryPolicy RetryPolicy
+
+	// RateLimiter limits the rate and concurrency of requests. Requests are
+	// not limited if it is nil.
+	RateLimiter *RateLimiter
+
+	// Logger logs every request and response at debug level. Requests are
+	// not logged if it is nil.
+	Logger logging.Logger
+
+	// LogBodies includes up to 4KB of the body of every request and response
+	// in the messages logged by Logger. Logging response bodies buffers them,
+	// so they are not read unless it is set.
+	LogBodies bool
+
+	// Cache serves GET requests from previously stored responses. Responses
+	// are not cached if it is nil.
+	Cache *Cache
 }
 
 // A ResponseErrorHandler handles errors in HTTP responses.
@@ -102,7 +159,7 @@ func (c *HTTPClient) NewRequest(ctx context.Context, method, prefix, urlPath str
 			return nil, err
 		}
 	}
-	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
+	req, err := http.NewRequestWithContext(withPrefix(ctx, prefix), method, u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
@@ -114,8 +171,13 @@ func (c *HTTPClient) NewRequest(ctx context.Context, method, prefix, urlPath str
 }
 
 // Do performs an HTTP request and reads the body into the provided interface.
+// The request is retried according to the client's RetryPolicy.
 func (c *HTTPClient) Do(req *http.Request, obj interface{}) error {
-	res, err := c.HTTP.Do(req)
+	// Pin the request ID so that every attempt shares it.
+	if request.IDFromContext(req.Context()) == "" {
+		req = req.WithContext(request.WithID(req.Context(), request.NewID()))
+	}
+	res, err := c.doCached(req)
 	if err != nil {
 		return errors.Wrap(err, fmt.Sprintf("failed to perform request with ID: %s", request.IDFromContext(req.Context())))
 	}
@@ -133,6 +195,88 @@ func (c *HTTPClient) Do(req *http.Request, obj interface{}) error {
 	return nil
 }
 
+// Stream performs an HTTP request and returns the response body unread. The
+// caller must close it. The request is retried according to the client's
+// RetryPolicy and counts against its RateLimiter until the body is closed, but
+// it is never cached and the client's timeout does not apply to it.
+func (c *HTTPClient) Stream(req *http.Request) (io.ReadCloser, error) {
+	ctx := withStreaming(WithoutCache(req.Context()))
+	if request.IDFromContext(ctx) == "" {
+		ctx = request.WithID(ctx, request.NewID())
+	}
+	req = req.WithContext(ctx)
+	sc := *c
+	if c.HTTP != nil {
+		h := *c.HTTP
+		h.Timeout = 0
+		sc.HTTP = &h
+	}
+	res, err := sc.doCached(req)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to perform request with ID: %s", request.IDFromContext(ctx)))
+	}
+	if err := c.handleErrors(res); err != nil {
+		res.Body.Close() // nolint:errcheck
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+type contextStreamingType struct{}
+
+var contextStreamingKey = &contextStreamingType{} //nolint:gochecknoglobals // This is intended to be global.
+
+// withStreaming marks a request whose response body is streamed to the caller.
+func withStreaming(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextStreamingKey, true)
+}
+
+func streaming(ctx context.Context) bool {
+	b, _ := ctx.Value(contextStreamingKey).(bool)
+	return b
+}
+
+// do sends the request until it succeeds or the RetryPolicy gives up.
+func (c *HTTPClient) do(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		start := time.Now()
+		res, err := c.send(req)
+		c.logAttempt(req, res, err, attempt, time.Since(start))
+		if c.RetryPolicy == nil {
+			return res, err
+		}
+		wait, retry := c.RetryPolicy.Retry(req, res, err, attempt)
+		if !retry || !rewind(req) {
+			return res, err
+		}
+		drain(res)
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// send sends the request once, waiting on the RateLimiter if configured. The
+// request counts against the RateLimiter's in-flight limit until its response
+// body is closed.
+func (c *HTTPClient) send(req *http.Request) (*http.Response, error) {
+	if c.RateLimiter == nil {
+		return c.HTTP.Do(req)
+	}
+	wait, release, err := c.RateLimiter.Wait(req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.HTTP.Do(req.WithContext(withRateLimitWait(req.Context(), wait)))
+	c.RateLimiter.Observe(req, res)
+	if err != nil || res.Body == nil {
+		release()
+		return res, err
+	}
+	res.Body = &releaseOnClose{ReadCloser: res.Body, release: release}
+	return res, nil
+}
+
 // handleErrors invokes the underlying response error handler.
 func (c *HTTPClient) handleErrors(res *http.Response) error {
 	return c.ErrorHandler.Handle(res)
@@ -145,6 +289,11 @@ func (c *HTTPClient) With(modifiers ...ClientModifierFn) Client {
 		ErrorHandler: c.ErrorHandler,
 		HTTP:         c.HTTP,
 		UserAgent:    c.UserAgent,
+		RetryPolicy:  c.RetryPolicy,
+		RateLimiter:  c.RateLimiter,
+		Logger:       c.Logger,
+		LogBodies:    c.LogBodies,
+		Cache:        c.Cache,
 	}
 	for _, m := range modifiers {
 		m(nc)
@@ -163,6 +312,12 @@ func (h *DefaultErrorHandler) Handle(res *http.Response) error {
 	if status >= 200 && status < 300 {
 		return nil
 	}
+	return withResponse(parseError(res), res)
+}
+
+// parseError reads the error from the response body.
+func parseError(res *http.Response) *uerrors.Error {
+	status := res.StatusCode
 	var rErr uerrors.Error
 	var details *string
 
@@ -170,6 +325,14 @@ func (h *DefaultErrorHandler) Handle(res *http.Response) error {
 	// if we can read the body, try to unmarshal it into an error
 	// and if that fails, use the body as the details
 	if err == nil {
+		if uerrors.IsProblem(res.Header.Get("Content-Type")) {
+			if p, err := uerrors.ParseProblem(b, status); err == nil {
+				return p
+			}
+		}
+		if st, err := uerrors.ParseStatus(b); err == nil {
+			return st
+		}
 		if err := json.Unmarshal(b, &rErr); err == nil && rErr.Status != 0 {
 			return &rErr
 		}
@@ -186,10 +349,33 @@ func (h *DefaultErrorHandler) Handle(res *http.Response) error {
 	}
 }
 
+// withResponse records the request that caused the error and how long the
+// server asked the client to wait before retrying it.
+func withResponse(e *uerrors.Error, res *http.Response) *uerrors.Error {
+	if d, ok := uerrors.ParseRetryAfter(res.Header.Get(headers.RetryAfterHeader), time.Now()); ok {
+		e.RetryAfter = d
+	}
+	if req := res.Request; req != nil {
+		e.Method = req.Method
+		e.URL = req.URL.String()
+		e.RequestID = request.IDFromContext(req.Context())
+		if e.RequestID == "" {
+			e.RequestID = req.Header.Get(headers.RequestIDHeader)
+		}
+	}
+	return e
+}
+
 // ContextTransport is a http.RoundTripper that enables the caller to propagate
 // information within the req.Context to external HTTP targets.
 type ContextTransport struct {
-	transport http.RoundTripper
+	transport   http.RoundTripper
+	credentials CredentialProvider
+
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+	duration   metric.Float64Histogram
+	errors     metric.Int64Counter
 }
 
 // ContextTransportOption modifies the underlying ContextTransport.
@@ -203,6 +389,14 @@ func WithTransport(t http.RoundTripper) ContextTransportOption {
 	}
 }
 
+// WithCredentialProvider sets the CredentialProvider used to authenticate
+// every request sent through the ContextTransport.
+func WithCredentialProvider(p CredentialProvider) ContextTransportOption {
+	return func(ct *ContextTransport) {
+		ct.credentials = p
+	}
+}
+
 // NewContextTransport constructs a new ContextTransport.
 func NewContextTransport(opts ...ContextTransportOption) *ContextTransport {
 	c := &ContextTransport{
@@ -216,9 +410,14 @@ func NewContextTransport(opts ...ContextTransportOption) *ContextTransport {
 }
 
 // RoundTrip adds information that is deemed important to propagate to the
-// target. Today we only propagate the request-id, but could expand this in
-// the future.
+// target, such as the request-id and trace context, and authenticates the
+// request if a CredentialProvider is configured. Spans and metrics are
+// recorded if a TracerProvider or MeterProvider is configured.
 func (c *ContextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Clone the request so that retried requests start from a clean slate.
+	req = req.Clone(req.Context())
+	req, span := c.startSpan(req)
+
 	// Retrieve x-request-id value from context.
 	id := request.IDFromContext(req.Context())
 	if id == "" {
@@ -227,5 +426,17 @@ func (c *ContextTransport) RoundTrip(req *http.Request) (*http.Response, error)
 	// Add value to the request.
 	req.Header.Add(headers.RequestIDHeader, id)
 
-	return c.transport.RoundTrip(req)
+	if c.credentials != nil {
+		if err := c.credentials.Authenticate(req); err != nil {
+			err = errors.Wrap(err, errAuthenticate)
+			endSpan(span, nil, err)
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	res, err := c.transport.RoundTrip(req)
+	c.record(req.Context(), req, res, err, time.Since(start))
+	endSpan(span, res, err)
+	return res, err
 }
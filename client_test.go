@@ -0,0 +1,155 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	uerrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/http/headers"
+	"github.com/upbound/up-sdk-go/http/request"
+)
+
+func TestDefaultErrorHandler(t *testing.T) {
+	detail := "slow down"
+	cases := map[string]struct {
+		reason string
+		status int
+		header http.Header
+		body   string
+		want   *uerrors.Error
+	}{
+		"RateLimited": {
+			reason: "The request and Retry-After should be recorded on the error.",
+			status: http.StatusTooManyRequests,
+			header: http.Header{headers.RetryAfterHeader: []string{"2"}},
+			body:   "slow down",
+			want: &uerrors.Error{
+				Status:     http.StatusTooManyRequests,
+				Title:      http.StatusText(http.StatusTooManyRequests),
+				Detail:     &detail,
+				RetryAfter: 2 * time.Second,
+				RequestID:  "id",
+				Method:     http.MethodGet,
+			},
+		},
+		"Problem": {
+			reason: "An RFC 7807 problem should be parsed.",
+			status: http.StatusForbidden,
+			header: http.Header{"Content-Type": []string{"application/problem+json; charset=utf-8"}},
+			body:   `{"type":"about:blank","title":"Forbidden","invalid-params":[{"name":"name","reason":"taken"}]}`,
+			want: &uerrors.Error{
+				Status:    http.StatusForbidden,
+				Title:     "Forbidden",
+				Type:      "about:blank",
+				Fields:    []uerrors.FieldError{{Field: "name", Message: "taken"}},
+				RequestID: "id",
+				Method:    http.MethodGet,
+			},
+		},
+		"Validation": {
+			reason: "Field errors in the response body should be recorded on the error.",
+			status: http.StatusBadRequest,
+			body:   `{"status":400,"title":"Bad Request","fields":[{"field":"name","message":"required"}]}`,
+			want: &uerrors.Error{
+				Status:    http.StatusBadRequest,
+				Title:     "Bad Request",
+				Fields:    []uerrors.FieldError{{Field: "name", Message: "required"}},
+				RequestID: "id",
+				Method:    http.MethodGet,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				for k, v := range tc.header {
+					w.Header()[k] = v
+				}
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer s.Close()
+			u, _ := url.Parse(s.URL)
+			c := NewClient(func(c *HTTPClient) {
+				c.BaseURL = u
+			})
+			req, _ := c.NewRequest(request.WithID(context.Background(), "id"), http.MethodGet, "v1", "robots", nil)
+			err := c.Do(req, nil)
+
+			got, ok := err.(*uerrors.Error) //nolint:errorlint // Do does not wrap response errors.
+			if !ok {
+				t.Fatalf("\n%s\nDo(...): expected an *errors.Error, got %v", tc.reason, err)
+			}
+			tc.want.URL = s.URL + "/v1/robots"
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDo(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestStream(t *testing.T) {
+	attempts := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("a"))
+		w.(http.Flusher).Flush()
+		// Outlast the client's timeout, which must not apply to streams.
+		time.Sleep(100 * time.Millisecond)
+		_, _ = w.Write([]byte("b"))
+	}))
+	defer s.Close()
+	u, _ := url.Parse(s.URL)
+	l := &recordingLogger{}
+	c := NewClient(func(c *HTTPClient) {
+		c.BaseURL = u
+		c.HTTP.Timeout = 50 * time.Millisecond
+	}, WithRetryPolicy(&BackoffRetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}), WithLogger(l))
+	req, err := c.NewRequest(context.Background(), http.MethodGet, "v1", "test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := c.Stream(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close() //nolint:errcheck // Nothing to do with the error.
+	b, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("ab", string(b)); diff != "" {
+		t.Errorf("\nStream(...): -want body, +got body:\n%s", diff)
+	}
+	if diff := cmp.Diff(2, attempts); diff != "" {
+		t.Errorf("\nStream(...): -want attempts, +got attempts:\n%s", diff)
+	}
+	if _, ok := l.entries[len(l.entries)-1]["response-body"]; ok {
+		t.Errorf("\nStream(...): the streamed response body should not be logged")
+	}
+}
@@ -23,8 +23,15 @@ type Config struct {
 	// Client is the underlying client.
 	Client Client
 
-	// Logger is the interface for structured logging.
+	// Logger is the interface for structured logging. If it is set by a
+	// ConfigModifierFn it is injected into Client when Client is an
+	// *HTTPClient without a Logger of its own, and used to log every request
+	// and response at debug level. It defaults to a no-op logger.
 	Logger logging.Logger
+
+	// Credentials authenticates requests sent by Client. It is only injected
+	// when Client is an *HTTPClient.
+	Credentials CredentialProvider
 }
 
 // A ConfigModifierFn modifies a Config.
@@ -34,10 +41,17 @@ type ConfigModifierFn func(*Config)
 func NewConfig(modifiers ...ConfigModifierFn) *Config {
 	c := &Config{
 		Client: NewClient(),
-		Logger: logging.NewNopLogger(),
 	}
 	for _, m := range modifiers {
 		m(c)
 	}
+	if hc, ok := c.Client.(*HTTPClient); ok && c.Credentials != nil {
+		c.Client = hc.With(WithCredentials(c.Credentials))
+	}
+	if c.Logger == nil {
+		c.Logger = logging.NewNopLogger()
+	} else if hc, ok := c.Client.(*HTTPClient); ok && hc.Logger == nil {
+		c.Client = hc.With(WithLogger(c.Logger))
+	}
 	return c
 }
@@ -0,0 +1,131 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+const (
+	// SessionCookieName is the name of the cookie used by Upbound to identify
+	// an authenticated session.
+	SessionCookieName = "session"
+
+	// DefaultTokenEnvVar is the environment variable read by an EnvProvider
+	// when no variable is explicitly configured.
+	DefaultTokenEnvVar = "UP_TOKEN"
+
+	errEmptyToken   = "credential provider has no token"
+	errEmptySession = "credential provider has no session"
+	errFmtEnvUnset  = "environment variable %s is not set"
+)
+
+// A CredentialProvider authenticates requests to Upbound.
+type CredentialProvider interface {
+	// Authenticate adds credentials to the supplied request.
+	Authenticate(req *http.Request) error
+}
+
+// CredentialProviderFn is a function that satisfies CredentialProvider.
+type CredentialProviderFn func(req *http.Request) error
+
+// Authenticate calls the underlying function.
+func (fn CredentialProviderFn) Authenticate(req *http.Request) error {
+	return fn(req)
+}
+
+var (
+	_ CredentialProvider = &StaticTokenProvider{}
+	_ CredentialProvider = &RobotTokenProvider{}
+	_ CredentialProvider = &SessionCookieProvider{}
+	_ CredentialProvider = &EnvProvider{}
+)
+
+// StaticTokenProvider authenticates requests with a fixed API token, such as a
+// user's personal access token.
+type StaticTokenProvider struct {
+	Token string
+}
+
+// Authenticate sets the token as a bearer token on the request.
+func (p *StaticTokenProvider) Authenticate(req *http.Request) error {
+	return setBearer(req, p.Token)
+}
+
+// RobotTokenProvider authenticates requests as a robot using one of the
+// robot's tokens.
+type RobotTokenProvider struct {
+	Token string
+}
+
+// Authenticate sets the robot token as a bearer token on the request.
+func (p *RobotTokenProvider) Authenticate(req *http.Request) error {
+	return setBearer(req, p.Token)
+}
+
+// SessionCookieProvider authenticates requests with an existing Upbound
+// session.
+type SessionCookieProvider struct {
+	Session string
+}
+
+// Authenticate adds the session cookie to the request.
+func (p *SessionCookieProvider) Authenticate(req *http.Request) error {
+	if p.Session == "" {
+		return errors.New(errEmptySession)
+	}
+	req.AddCookie(&http.Cookie{Name: SessionCookieName, Value: p.Session})
+	return nil
+}
+
+// EnvProvider authenticates requests with a token read from the environment
+// at the time each request is sent.
+type EnvProvider struct {
+	// Key is the environment variable holding the token. DefaultTokenEnvVar
+	// is used if it is empty.
+	Key string
+}
+
+// Authenticate sets the token held by the environment variable as a bearer
+// token on the request.
+func (p *EnvProvider) Authenticate(req *http.Request) error {
+	key := p.Key
+	if key == "" {
+		key = DefaultTokenEnvVar
+	}
+	t, ok := os.LookupEnv(key)
+	if !ok {
+		return errors.Errorf(errFmtEnvUnset, key)
+	}
+	return setBearer(req, t)
+}
+
+// setBearer sets token as the bearer token on the request. Requests that
+// already carry an Authorization header, such as token exchanges, are left
+// untouched.
+func setBearer(req *http.Request, token string) error {
+	if req.Header.Get("Authorization") != "" {
+		return nil
+	}
+	if token == "" {
+		return errors.New(errEmptyToken)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return nil
+}
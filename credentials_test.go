@@ -0,0 +1,145 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCredentialProviders(t *testing.T) {
+	type want struct {
+		authorization string
+		cookie        string
+		err           error
+	}
+	cases := map[string]struct {
+		reason   string
+		env      map[string]string
+		provider CredentialProvider
+		header   string
+		want     want
+	}{
+		"StaticToken": {
+			reason:   "A static token should be set as a bearer token.",
+			provider: &StaticTokenProvider{Token: "abc"},
+			want:     want{authorization: "Bearer abc"},
+		},
+		"StaticTokenEmpty": {
+			reason:   "An empty static token should return an error.",
+			provider: &StaticTokenProvider{},
+			want:     want{err: errors.New(errEmptyToken)},
+		},
+		"StaticTokenExistingHeader": {
+			reason:   "An Authorization header set on the request should not be overwritten.",
+			provider: &StaticTokenProvider{Token: "abc"},
+			header:   "Bearer explicit",
+			want:     want{authorization: "Bearer explicit"},
+		},
+		"RobotToken": {
+			reason:   "A robot token should be set as a bearer token.",
+			provider: &RobotTokenProvider{Token: "robot"},
+			want:     want{authorization: "Bearer robot"},
+		},
+		"SessionCookie": {
+			reason:   "A session should be added as the session cookie.",
+			provider: &SessionCookieProvider{Session: "session"},
+			want:     want{cookie: "session"},
+		},
+		"SessionCookieEmpty": {
+			reason:   "An empty session should return an error.",
+			provider: &SessionCookieProvider{},
+			want:     want{err: errors.New(errEmptySession)},
+		},
+		"EnvDefaultKey": {
+			reason:   "The token should be read from the default environment variable.",
+			env:      map[string]string{DefaultTokenEnvVar: "from-env"},
+			provider: &EnvProvider{},
+			want:     want{authorization: "Bearer from-env"},
+		},
+		"EnvCustomKey": {
+			reason:   "The token should be read from the configured environment variable.",
+			env:      map[string]string{"MY_TOKEN": "custom"},
+			provider: &EnvProvider{Key: "MY_TOKEN"},
+			want:     want{authorization: "Bearer custom"},
+		},
+		"EnvUnset": {
+			reason:   "An unset environment variable should return an error.",
+			provider: &EnvProvider{Key: "UP_SDK_GO_TEST_UNSET"},
+			want:     want{err: errors.Errorf(errFmtEnvUnset, "UP_SDK_GO_TEST_UNSET")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+			req := httptest.NewRequest(http.MethodGet, "https://api.upbound.io/v1/self", nil)
+			if tc.header != "" {
+				req.Header.Set("Authorization", tc.header)
+			}
+			err := tc.provider.Authenticate(req)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nAuthenticate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.authorization, req.Header.Get("Authorization")); diff != "" {
+				t.Errorf("\n%s\nAuthenticate(...): -want authorization, +got authorization:\n%s", tc.reason, diff)
+			}
+			cookie := ""
+			if c, err := req.Cookie(SessionCookieName); err == nil {
+				cookie = c.Value
+			}
+			if diff := cmp.Diff(tc.want.cookie, cookie); diff != "" {
+				t.Errorf("\n%s\nAuthenticate(...): -want cookie, +got cookie:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConfigCredentials(t *testing.T) {
+	var got string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+	}))
+	defer s.Close()
+
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := NewConfig(func(cfg *Config) {
+		cfg.Client = NewClient(func(c *HTTPClient) {
+			c.BaseURL = u
+		})
+		cfg.Credentials = &StaticTokenProvider{Token: "abc"}
+	})
+	req, err := cfg.Client.NewRequest(context.Background(), http.MethodGet, "v1", "self", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Client.Do(req, nil); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("Bearer abc", got); diff != "" {
+		t.Errorf("\nNewConfig(...): credentials should be injected into the client: -want, +got:\n%s", diff)
+	}
+}
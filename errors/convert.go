@@ -0,0 +1,139 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ContentTypeProblemJSON is the content type of an RFC 7807 problem.
+const ContentTypeProblemJSON = "application/problem+json"
+
+var errNotStatus = errors.New("not a Kubernetes Status")
+
+// APIStatus is implemented by errors that carry a Kubernetes Status, such as
+// the *k8s.io/apimachinery/pkg/api/errors.StatusError in the chain of errors
+// returned by Spaces.
+type APIStatus interface {
+	Status() metav1.Status
+}
+
+// From returns the Upbound SDK error in the chain of err. A Kubernetes Status
+// error is converted to an equivalent *Error. It returns false if err is not
+// an error returned by an Upbound API.
+func From(err error) (*Error, bool) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e, true
+	}
+	var s APIStatus
+	if errors.As(err, &s) {
+		return FromStatus(s.Status()), true
+	}
+	return nil, false
+}
+
+// FromStatus converts a Kubernetes Status to an *Error.
+func FromStatus(s metav1.Status) *Error {
+	e := &Error{
+		Status: int(s.Code),
+		Title:  http.StatusText(int(s.Code)),
+		Type:   string(s.Reason),
+	}
+	if s.Message != "" {
+		e.Detail = &s.Message
+	}
+	if d := s.Details; d != nil {
+		for _, c := range d.Causes {
+			e.Fields = append(e.Fields, FieldError{Field: c.Field, Message: c.Message})
+		}
+		e.RetryAfter = time.Duration(d.RetryAfterSeconds) * time.Second
+	}
+	return e
+}
+
+// IsProblem returns true if the content type is that of an RFC 7807 problem.
+func IsProblem(contentType string) bool {
+	mt, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mt == ContentTypeProblemJSON
+}
+
+// problem is an RFC 7807 problem, including the invalid-params extension
+// member used to describe validation errors.
+type problem struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail"`
+	InvalidParams []invalidParam `json:"invalid-params"`
+	Fields        []FieldError   `json:"fields"`
+}
+
+type invalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// ParseProblem parses an RFC 7807 problem into an *Error. The status of the
+// response is used if the problem does not include one.
+func ParseProblem(data []byte, status int) (*Error, error) {
+	p := &problem{}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, err
+	}
+	e := &Error{
+		Status: p.Status,
+		Title:  p.Title,
+		Type:   p.Type,
+		Fields: p.Fields,
+	}
+	if e.Status == 0 {
+		e.Status = status
+	}
+	if e.Title == "" {
+		e.Title = http.StatusText(e.Status)
+	}
+	if p.Detail != "" {
+		e.Detail = &p.Detail
+	}
+	for _, ip := range p.InvalidParams {
+		e.Fields = append(e.Fields, FieldError{Field: ip.Name, Message: ip.Reason})
+	}
+	return e, nil
+}
+
+// ParseStatus parses a Kubernetes Status, as returned by Spaces, into an
+// *Error. The *StatusError it describes is kept as the Error's Err, so that
+// it can still be inspected with the Kubernetes API error helpers. An error is
+// returned if the data is not a Status.
+func ParseStatus(data []byte) (*Error, error) {
+	s := metav1.Status{}
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	if s.Kind != "Status" || s.Code == 0 {
+		return nil, errNotStatus
+	}
+	e := FromStatus(s)
+	e.Err = &apierrors.StatusError{ErrStatus: s}
+	return e, nil
+}
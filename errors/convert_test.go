@@ -0,0 +1,182 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestStatusErrorPredicates(t *testing.T) {
+	gr := schema.GroupResource{Group: "spaces.upbound.io", Resource: "controlplanes"}
+	type want struct {
+		notFound      bool
+		conflict      bool
+		alreadyExists bool
+		rateLimited   bool
+		validation    bool
+		retryAfter    time.Duration
+		fields        []FieldError
+	}
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   want
+	}{
+		"NotFound": {
+			reason: "A Kubernetes NotFound error should be recognized.",
+			err:    apierrors.NewNotFound(gr, "ctp"),
+			want:   want{notFound: true},
+		},
+		"AlreadyExists": {
+			reason: "A Kubernetes AlreadyExists error should be recognized as a conflict.",
+			err:    errors.Wrap(apierrors.NewAlreadyExists(gr, "ctp"), "cannot create control plane"),
+			want:   want{conflict: true, alreadyExists: true},
+		},
+		"TooManyRequests": {
+			reason: "A Kubernetes TooManyRequests error should carry its retry delay.",
+			err:    apierrors.NewTooManyRequests("slow down", 3),
+			want:   want{rateLimited: true, retryAfter: 3 * time.Second},
+		},
+		"Invalid": {
+			reason: "A Kubernetes Invalid error should carry its field errors.",
+			err: apierrors.NewInvalid(schema.GroupKind{Group: "spaces.upbound.io", Kind: "ControlPlane"}, "ctp", field.ErrorList{
+				field.Required(field.NewPath("spec", "crossplane"), "is required"),
+			}),
+			want: want{validation: true, fields: []FieldError{{Field: "spec.crossplane", Message: "Required value: is required"}}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			retryAfter, _ := RetryAfter(tc.err)
+			got := want{
+				notFound:      IsNotFound(tc.err),
+				conflict:      IsConflict(tc.err),
+				alreadyExists: IsAlreadyExists(tc.err),
+				rateLimited:   IsRateLimited(tc.err),
+				validation:    IsValidation(tc.err),
+				retryAfter:    retryAfter,
+				fields:        FieldErrors(tc.err),
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nIs(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestParseProblem(t *testing.T) {
+	detail := "name is invalid"
+	type want struct {
+		err    *Error
+		failed bool
+	}
+	cases := map[string]struct {
+		reason string
+		data   string
+		status int
+		want   want
+	}{
+		"Problem": {
+			reason: "An RFC 7807 problem should be parsed, including invalid params.",
+			data:   `{"type":"https://upbound.io/problems/validation","title":"Invalid robot","status":422,"detail":"name is invalid","invalid-params":[{"name":"name","reason":"must not be empty"}]}`,
+			status: http.StatusUnprocessableEntity,
+			want: want{err: &Error{
+				Status: http.StatusUnprocessableEntity,
+				Title:  "Invalid robot",
+				Detail: &detail,
+				Type:   "https://upbound.io/problems/validation",
+				Fields: []FieldError{{Field: "name", Message: "must not be empty"}},
+			}},
+		},
+		"MissingStatus": {
+			reason: "The response status should be used if the problem does not include one.",
+			data:   `{"type":"about:blank"}`,
+			status: http.StatusForbidden,
+			want: want{err: &Error{
+				Status: http.StatusForbidden,
+				Title:  http.StatusText(http.StatusForbidden),
+				Type:   "about:blank",
+			}},
+		},
+		"Invalid": {
+			reason: "An invalid problem should return an error.",
+			data:   `not json`,
+			status: http.StatusForbidden,
+			want:   want{failed: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, err := ParseProblem([]byte(tc.data), tc.status)
+			if diff := cmp.Diff(tc.want, want{err: e, failed: err != nil}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nParseProblem(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestParseStatus(t *testing.T) {
+	detail := `controlplanes.spaces.upbound.io "ctp" not found`
+	type want struct {
+		err      *Error
+		notFound bool
+		failed   bool
+	}
+	cases := map[string]struct {
+		reason string
+		data   string
+		want   want
+	}{
+		"Status": {
+			reason: "A Kubernetes Status should be parsed, keeping the StatusError it describes.",
+			data:   `{"kind":"Status","apiVersion":"v1","status":"Failure","message":"controlplanes.spaces.upbound.io \"ctp\" not found","reason":"NotFound","code":404}`,
+			want: want{
+				err:      &Error{Status: http.StatusNotFound, Title: http.StatusText(http.StatusNotFound), Detail: &detail, Type: "NotFound"},
+				notFound: true,
+			},
+		},
+		"NotStatus": {
+			reason: "An object that is not a Status should return an error.",
+			data:   `{"status":404,"title":"Not Found"}`,
+			want:   want{failed: true},
+		},
+		"Invalid": {
+			reason: "An invalid Status should return an error.",
+			data:   `not json`,
+			want:   want{failed: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, err := ParseStatus([]byte(tc.data))
+			got := want{err: e, failed: err != nil}
+			if e != nil {
+				got.notFound = apierrors.IsNotFound(e)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), cmpopts.IgnoreFields(Error{}, "Err")); diff != "" {
+				t.Errorf("\n%s\nParseStatus(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -15,17 +15,82 @@
 package errors
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ error = &Error{}
 
-// Error is an Upbound SDK error response.
+// Sentinel errors that an *Error matches with errors.Is according to its
+// status.
+var (
+	// ErrUnauthorized matches errors caused by missing or invalid
+	// credentials.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden matches errors caused by insufficient permissions.
+	ErrForbidden = errors.New("forbidden")
+	// ErrNotFound matches errors caused by a missing resource.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict matches errors caused by a conflict with the current state
+	// of a resource, including AlreadyExists.
+	ErrConflict = errors.New("conflict")
+	// ErrAlreadyExists matches errors caused by creating a resource that
+	// already exists.
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrRateLimited matches errors caused by exceeding a rate limit.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrValidation matches errors caused by an invalid request.
+	ErrValidation = errors.New("validation failed")
+	// ErrServerError matches errors caused by a failure of the server.
+	ErrServerError = errors.New("server error")
+)
+
+// Error is an Upbound SDK error response. Errors returned by the Kubernetes
+// style APIs served by Spaces, and RFC 7807 problem details, can be converted
+// to an Error with From.
 type Error struct {
 	Status int     `json:"status"`
 	Title  string  `json:"title"`
 	Detail *string `json:"detail,omitempty"`
+
+	// Type identifies the kind of error. It is the problem type URI of an
+	// RFC 7807 problem, or the reason of a Kubernetes Status.
+	Type string `json:"type,omitempty"`
+
+	// Fields describes the invalid fields of a request that failed
+	// validation.
+	Fields []FieldError `json:"fields,omitempty"`
+
+	// RetryAfter is how long the server asked the client to wait before
+	// retrying, if it did.
+	RetryAfter time.Duration `json:"-"`
+
+	// RequestID identifies the request that caused the error.
+	RequestID string `json:"-"`
+
+	// Method is the HTTP method of the request that caused the error.
+	Method string `json:"-"`
+
+	// URL is the URL of the request that caused the error.
+	URL string `json:"-"`
+
+	// Err is the error the Error was parsed from, if any, e.g. the
+	// *StatusError described by a Kubernetes Status.
+	Err error `json:"-"`
+}
+
+// FieldError describes why a single field of a request is invalid.
+type FieldError struct {
+	// Field is the path to the field, e.g. spec.name.
+	Field string `json:"field"`
+
+	// Message describes why the field is invalid.
+	Message string `json:"message"`
 }
 
 // Error returns the error message with the underlying error.
@@ -37,6 +102,43 @@ func (e *Error) Error() string {
 	return fmt.Sprintf("%s%s", e.Title, detail)
 }
 
+// Unwrap returns the error the Error was parsed from, if any.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is returns true if the target is the sentinel error matching the error's
+// status.
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.Status == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.Status == http.StatusForbidden
+	case ErrNotFound:
+		return e.Status == http.StatusNotFound
+	case ErrConflict:
+		return e.Status == http.StatusConflict
+	case ErrAlreadyExists:
+		return e.Status == http.StatusConflict && (e.Type == string(metav1.StatusReasonAlreadyExists) || e.mentions("already exists"))
+	case ErrRateLimited:
+		return e.Status == http.StatusTooManyRequests
+	case ErrValidation:
+		return e.Status == http.StatusBadRequest || e.Status == http.StatusUnprocessableEntity
+	case ErrServerError:
+		return e.Status >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// mentions returns true if the title or detail contains s, ignoring case.
+func (e *Error) mentions(s string) bool {
+	if strings.Contains(strings.ToLower(e.Title), s) {
+		return true
+	}
+	return e.Detail != nil && strings.Contains(strings.ToLower(*e.Detail), s)
+}
+
 // IsNotFound returns true if the error type is ErrorTypeNotFound, and false
 // otherwise.
 func (e *Error) IsNotFound() bool {
@@ -46,11 +148,83 @@ func (e *Error) IsNotFound() bool {
 // IsNotFound returns true if the error is an Upbound SDK NotFound error, and
 // false otherwise.
 func IsNotFound(err error) bool {
-	e, ok := err.(interface { // nolint:errorlint
+	var e interface {
 		IsNotFound() bool
-	})
+	}
+	if errors.As(err, &e) {
+		return e.IsNotFound()
+	}
+	return is(err, ErrNotFound)
+}
+
+// IsUnauthorized returns true if the error was caused by missing or invalid
+// credentials.
+func IsUnauthorized(err error) bool {
+	return is(err, ErrUnauthorized)
+}
+
+// IsForbidden returns true if the error was caused by insufficient
+// permissions.
+func IsForbidden(err error) bool {
+	return is(err, ErrForbidden)
+}
+
+// IsConflict returns true if the error was caused by a conflict with the
+// current state of a resource.
+func IsConflict(err error) bool {
+	return is(err, ErrConflict)
+}
+
+// IsAlreadyExists returns true if the error was caused by creating a resource
+// that already exists.
+func IsAlreadyExists(err error) bool {
+	return is(err, ErrAlreadyExists)
+}
+
+// IsRateLimited returns true if the error was caused by exceeding a rate
+// limit.
+func IsRateLimited(err error) bool {
+	return is(err, ErrRateLimited)
+}
+
+// IsValidation returns true if the error was caused by an invalid request.
+func IsValidation(err error) bool {
+	return is(err, ErrValidation)
+}
+
+// IsServerError returns true if the error was caused by a failure of the
+// server.
+func IsServerError(err error) bool {
+	return is(err, ErrServerError)
+}
+
+// is returns true if the error, in any of the shapes understood by From,
+// matches the sentinel.
+func is(err, sentinel error) bool {
+	if errors.Is(err, sentinel) {
+		return true
+	}
+	e, ok := From(err)
+	return ok && e.Is(sentinel)
+}
+
+// RetryAfter returns how long the server asked the client to wait before
+// retrying the request that caused the error. It returns false if the server
+// did not say.
+func RetryAfter(err error) (time.Duration, bool) {
+	e, ok := From(err)
+	if !ok || e.RetryAfter == 0 {
+		return 0, false
+	}
+	return e.RetryAfter, true
+}
+
+// FieldErrors returns the invalid fields of the request that caused the
+// error, if any.
+func FieldErrors(err error) []FieldError {
+	e, ok := From(err)
 	if !ok {
-		return false
+		return nil
 	}
-	return e.IsNotFound()
+	return e.Fields
 }
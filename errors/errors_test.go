@@ -17,6 +17,7 @@ package errors
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -54,3 +55,124 @@ func TestIsNotFound(t *testing.T) {
 		})
 	}
 }
+
+func TestIs(t *testing.T) {
+	detail := "robot already exists"
+	type want struct {
+		unauthorized  bool
+		forbidden     bool
+		conflict      bool
+		alreadyExists bool
+		rateLimited   bool
+		validation    bool
+		serverError   bool
+	}
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   want
+	}{
+		"Unauthorized": {
+			reason: "A 401 should match ErrUnauthorized.",
+			err:    &Error{Status: http.StatusUnauthorized},
+			want:   want{unauthorized: true},
+		},
+		"Forbidden": {
+			reason: "A 403 should match ErrForbidden.",
+			err:    &Error{Status: http.StatusForbidden},
+			want:   want{forbidden: true},
+		},
+		"Conflict": {
+			reason: "A 409 should match ErrConflict.",
+			err:    &Error{Status: http.StatusConflict, Title: "Conflict"},
+			want:   want{conflict: true},
+		},
+		"AlreadyExists": {
+			reason: "A 409 saying the resource already exists should match ErrAlreadyExists and ErrConflict.",
+			err:    &Error{Status: http.StatusConflict, Title: "Conflict", Detail: &detail},
+			want:   want{conflict: true, alreadyExists: true},
+		},
+		"RateLimited": {
+			reason: "A 429 should match ErrRateLimited.",
+			err:    &Error{Status: http.StatusTooManyRequests},
+			want:   want{rateLimited: true},
+		},
+		"Validation": {
+			reason: "A 422 should match ErrValidation.",
+			err:    &Error{Status: http.StatusUnprocessableEntity},
+			want:   want{validation: true},
+		},
+		"ServerError": {
+			reason: "A 503 should match ErrServerError.",
+			err:    &Error{Status: http.StatusServiceUnavailable},
+			want:   want{serverError: true},
+		},
+		"Wrapped": {
+			reason: "A wrapped error should match.",
+			err:    errors.Wrap(&Error{Status: http.StatusForbidden}, "cannot get robot"),
+			want:   want{forbidden: true},
+		},
+		"NotUpError": {
+			reason: "An error that is not an Upbound SDK error should not match.",
+			err:    errors.New("other error"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{
+				unauthorized:  IsUnauthorized(tc.err),
+				forbidden:     IsForbidden(tc.err),
+				conflict:      IsConflict(tc.err),
+				alreadyExists: IsAlreadyExists(tc.err),
+				rateLimited:   IsRateLimited(tc.err),
+				validation:    IsValidation(tc.err),
+				serverError:   IsServerError(tc.err),
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nIs(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	type want struct {
+		d  time.Duration
+		ok bool
+	}
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   want
+	}{
+		"RetryAfter": {
+			reason: "The wait requested by the server should be returned.",
+			err:    errors.Wrap(&Error{Status: http.StatusTooManyRequests, RetryAfter: time.Second}, "cannot list robots"),
+			want:   want{d: time.Second, ok: true},
+		},
+		"NoRetryAfter": {
+			reason: "No wait should be returned if the server did not request one.",
+			err:    &Error{Status: http.StatusTooManyRequests},
+		},
+		"NotUpError": {
+			reason: "No wait should be returned for an error that is not an Upbound SDK error.",
+			err:    errors.New("other error"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d, ok := RetryAfter(tc.err)
+			if diff := cmp.Diff(tc.want, want{d: d, ok: ok}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nRetryAfter(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFieldErrors(t *testing.T) {
+	fields := []FieldError{{Field: "name", Message: "must not be empty"}}
+	err := errors.Wrap(&Error{Status: http.StatusBadRequest, Fields: fields}, "cannot create robot")
+	if diff := cmp.Diff(fields, FieldErrors(err)); diff != "" {
+		t.Errorf("\nFieldErrors(...): -want, +got:\n%s", diff)
+	}
+}
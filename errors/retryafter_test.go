@@ -0,0 +1,71 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	type want struct {
+		d  time.Duration
+		ok bool
+	}
+	cases := map[string]struct {
+		reason string
+		value  string
+		want   want
+	}{
+		"Empty": {
+			reason: "An empty header should not be parsed.",
+		},
+		"Seconds": {
+			reason: "A number of seconds should be parsed.",
+			value:  "3",
+			want:   want{d: 3 * time.Second, ok: true},
+		},
+		"Negative": {
+			reason: "A negative number of seconds is invalid.",
+			value:  "-1",
+		},
+		"Date": {
+			reason: "An HTTP date should be parsed relative to now.",
+			value:  now.Add(5 * time.Second).Format(http.TimeFormat),
+			want:   want{d: 5 * time.Second, ok: true},
+		},
+		"PastDate": {
+			reason: "An HTTP date in the past means no wait.",
+			value:  now.Add(-5 * time.Second).Format(http.TimeFormat),
+			want:   want{ok: true},
+		},
+		"Garbage": {
+			reason: "An unparseable value should be ignored.",
+			value:  "soon",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d, ok := ParseRetryAfter(tc.value, now)
+			if diff := cmp.Diff(tc.want, want{d: d, ok: ok}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nParseRetryAfter(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -16,17 +16,22 @@ package fake
 
 import (
 	"context"
+	"io"
 	"net/http"
 
 	"github.com/upbound/up-sdk-go"
 )
 
-var _ up.Client = &MockClient{}
+var (
+	_ up.Client   = &MockClient{}
+	_ up.Streamer = &MockClient{}
+)
 
 // MockClient is a mock of an Upbound SDK Client.
 type MockClient struct {
 	MockNewRequest func(ctx context.Context, method, prefix, urlPath string, body interface{}) (*http.Request, error)
 	MockDo         func(req *http.Request, obj interface{}) error
+	MockStream     func(req *http.Request) (io.ReadCloser, error)
 	MockWith       func(modifiers ...up.ClientModifierFn) up.Client
 }
 
@@ -54,6 +59,11 @@ func (m *MockClient) Do(req *http.Request, obj interface{}) error {
 	return m.MockDo(req, obj)
 }
 
+// Stream calls the underlying MockStream function.
+func (m *MockClient) Stream(req *http.Request) (io.ReadCloser, error) {
+	return m.MockStream(req)
+}
+
 // With implements up.Client.
 func (m *MockClient) With(modifiers ...up.ClientModifierFn) up.Client {
 	return m.MockWith(modifiers...)
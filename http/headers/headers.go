@@ -9,3 +9,12 @@ package headers
 // through the system. See the envoy docs for more information.
 // Ref: https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_conn_man/headers#x-request-id
 const RequestIDHeader = "x-request-id"
+
+// RetryAfterHeader indicates how long a client should wait before making a
+// follow-up request.
+// Ref: https://www.rfc-editor.org/rfc/rfc9110#name-retry-after
+const RetryAfterHeader = "Retry-After"
+
+// IdempotencyKeyHeader marks a request as safe to repeat. Requests carrying it
+// may be retried even if their method is not idempotent.
+const IdempotencyKeyHeader = "Idempotency-Key"
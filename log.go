@@ -0,0 +1,167 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+
+	"github.com/upbound/up-sdk-go/http/request"
+)
+
+const (
+	// redacted replaces sensitive values in logs.
+	redacted = "REDACTED"
+
+	// maxLoggedBody is the maximum number of bytes of a request or response
+	// body that is logged.
+	maxLoggedBody = 4096
+
+	// tokenExchangePrefix is the prefix of the token exchange API.
+	tokenExchangePrefix = "apis/tokenexchange.upbound.io"
+)
+
+// redactedHeaders are never logged.
+var redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"} //nolint:gochecknoglobals // This is intended to be global.
+
+// WithLogger sets the Logger used by the client to log every request and
+// response at debug level. Requests are not logged if it is nil.
+func WithLogger(l logging.Logger) ClientModifierFn {
+	return func(c *HTTPClient) {
+		c.Logger = l
+	}
+}
+
+// WithBodyLogging includes the bodies of requests and responses in the
+// messages logged by the client's Logger.
+func WithBodyLogging() ClientModifierFn {
+	return func(c *HTTPClient) {
+		c.LogBodies = true
+	}
+}
+
+// logAttempt logs a single attempt to send the request. Credentials and the
+// bodies of requests that carry tokens are redacted.
+func (c *HTTPClient) logAttempt(req *http.Request, res *http.Response, err error, attempt int, latency time.Duration) {
+	if c.Logger == nil {
+		return
+	}
+	sensitive := isSensitivePath(req.URL.Path)
+	kv := []any{
+		"method", req.Method,
+		"path", req.URL.Path,
+		"request-id", request.IDFromContext(req.Context()),
+		"attempt", attempt,
+		"latency", latency,
+		"request-headers", redactHeaders(req.Header),
+	}
+	if c.LogBodies {
+		kv = append(kv, "request-body", requestBody(req, sensitive))
+	}
+	if err != nil {
+		c.Logger.Debug("Request failed", append(kv, "error", err)...)
+		return
+	}
+	kv = append(kv,
+		"status", res.StatusCode,
+		"response-headers", redactHeaders(res.Header),
+	)
+	// Streamed bodies may never end, so they are not read for logging.
+	if c.LogBodies && !streaming(req.Context()) {
+		kv = append(kv, "response-body", responseBody(res, sensitive))
+	}
+	c.Logger.Debug("Received response", kv...)
+}
+
+// isSensitivePath returns true if requests to the path may carry tokens in
+// their request or response bodies.
+func isSensitivePath(p string) bool {
+	p = strings.Trim(p, "/")
+	if strings.HasPrefix(p, tokenExchangePrefix) {
+		return true
+	}
+	for _, s := range strings.Split(p, "/") {
+		if strings.HasSuffix(s, "tokens") {
+			return true
+		}
+	}
+	return false
+}
+
+// redactHeaders returns a copy of the headers with credentials redacted.
+func redactHeaders(h http.Header) http.Header {
+	r := h.Clone()
+	for _, k := range redactedHeaders {
+		if _, ok := r[k]; ok {
+			r[k] = []string{redacted}
+		}
+	}
+	return r
+}
+
+// requestBody returns the request body for logging. The body is read from
+// GetBody so that the request itself is left untouched.
+func requestBody(req *http.Request, sensitive bool) string {
+	if req.GetBody == nil || req.ContentLength == 0 {
+		return ""
+	}
+	if sensitive {
+		return redacted
+	}
+	b, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer b.Close() //nolint:errcheck // Nothing to do with the error.
+	return readLogged(b)
+}
+
+// responseBody returns the response body for logging. The logged prefix of
+// the body is stitched back in front of the remainder so that the caller can
+// still read all of it.
+func responseBody(res *http.Response, sensitive bool) string {
+	if res.Body == nil || res.Body == http.NoBody {
+		return ""
+	}
+	if sensitive {
+		return redacted
+	}
+	var buf bytes.Buffer
+	_, _ = io.Copy(&buf, io.LimitReader(res.Body, maxLoggedBody+1))
+	res.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(buf.Bytes()), res.Body), Closer: res.Body}
+	return truncate(buf.Bytes())
+}
+
+func readLogged(r io.Reader) string {
+	b, _ := io.ReadAll(io.LimitReader(r, maxLoggedBody+1))
+	return truncate(b)
+}
+
+func truncate(b []byte) string {
+	if len(b) > maxLoggedBody {
+		return string(b[:maxLoggedBody]) + "...(truncated)"
+	}
+	return string(b)
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,173 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/upbound/up-sdk-go/http/request"
+)
+
+// recordingLogger records the key/value pairs of every debug message.
+type recordingLogger struct {
+	entries []map[string]any
+}
+
+func (l *recordingLogger) Info(string, ...any) {}
+
+func (l *recordingLogger) Debug(msg string, keysAndValues ...any) {
+	e := map[string]any{"msg": msg}
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		e[keysAndValues[i].(string)] = keysAndValues[i+1]
+	}
+	l.entries = append(l.entries, e)
+}
+
+func (l *recordingLogger) WithValues(...any) logging.Logger { return l }
+
+func TestLogAttempt(t *testing.T) {
+	type want struct {
+		status        any
+		authorization string
+		requestBody   any
+		responseBody  any
+		readBody      string
+	}
+	cases := map[string]struct {
+		reason string
+		bodies bool
+		prefix string
+		path   string
+		body   any
+		want   want
+	}{
+		"Logged": {
+			reason: "Requests and responses should be logged with their bodies if body logging is enabled.",
+			bodies: true,
+			prefix: "v1",
+			path:   "self",
+			body:   map[string]string{"a": "b"},
+			want: want{
+				status:        http.StatusOK,
+				authorization: redacted,
+				requestBody:   "{\"a\":\"b\"}\n",
+				responseBody:  "{\"token\":\"secret\"}",
+				readBody:      "{\"token\":\"secret\"}",
+			},
+		},
+		"NoBodies": {
+			reason: "Bodies should not be read or logged unless body logging is enabled.",
+			prefix: "v1",
+			path:   "self",
+			body:   map[string]string{"a": "b"},
+			want: want{
+				status:        http.StatusOK,
+				authorization: redacted,
+				readBody:      "{\"token\":\"secret\"}",
+			},
+		},
+		"Tokens": {
+			reason: "The bodies of token requests should be redacted.",
+			bodies: true,
+			prefix: "v1/tokens",
+			body:   map[string]string{"a": "b"},
+			want: want{
+				status:        http.StatusOK,
+				authorization: redacted,
+				requestBody:   redacted,
+				responseBody:  redacted,
+				readBody:      "{\"token\":\"secret\"}",
+			},
+		},
+		"TokenExchange": {
+			reason: "The bodies of token exchange requests should be redacted.",
+			bodies: true,
+			prefix: "/apis/tokenexchange.upbound.io/v1alpha1",
+			path:   "orgscopedtokens",
+			body:   map[string]string{"a": "b"},
+			want: want{
+				status:        http.StatusOK,
+				authorization: redacted,
+				requestBody:   redacted,
+				responseBody:  redacted,
+				readBody:      "{\"token\":\"secret\"}",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			l := &recordingLogger{}
+			c := NewClient(func(c *HTTPClient) {
+				c.HTTP = &http.Client{Transport: roundTripperFn(func(r *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Header:     http.Header{},
+						Body:       io.NopCloser(strings.NewReader("{\"token\":\"secret\"}")),
+					}, nil
+				})}
+				c.LogBodies = tc.bodies
+			}, WithLogger(l))
+			req, _ := c.NewRequest(request.WithID(context.Background(), "id"), http.MethodPost, tc.prefix, tc.path, tc.body)
+			req.Header.Set("Authorization", "Bearer secret")
+
+			var out map[string]string
+			if err := c.Do(req, &out); err != nil {
+				t.Fatal(err)
+			}
+			if len(l.entries) != 1 {
+				t.Fatalf("\n%s\nDo(...): expected one log entry, got %v", tc.reason, l.entries)
+			}
+			e := l.entries[0]
+			got := want{
+				status:        e["status"],
+				authorization: e["request-headers"].(http.Header).Get("Authorization"),
+				requestBody:   e["request-body"],
+				responseBody:  e["response-body"],
+				readBody:      "{\"token\":\"" + out["token"] + "\"}",
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nDo(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if e["request-id"] != "id" || e["attempt"] != 1 || e["latency"].(time.Duration) < 0 {
+				t.Errorf("\n%s\nDo(...): unexpected request metadata %v", tc.reason, e)
+			}
+		})
+	}
+}
+
+func TestConfigLogger(t *testing.T) {
+	l := &recordingLogger{}
+	cfg := NewConfig(func(c *Config) {
+		c.Logger = l
+	})
+	if got := cfg.Client.(*HTTPClient).Logger; got != l {
+		t.Errorf("\nNewConfig(...): the logger should be injected into the client, got %v", got)
+	}
+	def := NewConfig()
+	if got := def.Client.(*HTTPClient).Logger; got != nil {
+		t.Errorf("\nNewConfig(...): the default logger should not be injected, got %v", got)
+	}
+	if def.Logger == nil {
+		t.Errorf("\nNewConfig(...): the logger should default to a no-op logger")
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// minAdaptiveFraction is the lowest fraction of the configured QPS that
+	// the limiter backs off to after repeated 429 responses.
+	minAdaptiveFraction = 0.05
+	// recoveryFraction is the fraction of the configured QPS restored after
+	// every successful response.
+	recoveryFraction = 0.1
+)
+
+// WithRateLimiter sets the RateLimiter used by the client. Requests are not
+// rate limited when it is nil.
+func WithRateLimiter(l *RateLimiter) ClientModifierFn {
+	return func(c *HTTPClient) {
+		c.RateLimiter = l
+	}
+}
+
+// RateLimit configures client-side limits for a set of requests.
+type RateLimit struct {
+	// QPS is the sustained number of requests per second. Requests are not
+	// rate limited if it is zero, and the limit then does not adapt to 429
+	// Too Many Requests responses either.
+	QPS float64
+
+	// Burst is the number of requests that may be sent at once before QPS
+	// applies. It defaults to 1.
+	Burst int
+
+	// MaxInFlight is the maximum number of concurrent requests. Concurrency
+	// is not limited if it is zero.
+	MaxInFlight int
+}
+
+// A RateLimiterOption modifies a RateLimiter.
+type RateLimiterOption func(*RateLimiter)
+
+// ForBaseURL applies the limit to requests sent to the given base URL, e.g.
+// https://api.upbound.io.
+func ForBaseURL(baseURL string, l RateLimit) RateLimiterOption {
+	return func(r *RateLimiter) {
+		r.hosts[strings.TrimSuffix(baseURL, "/")] = l
+	}
+}
+
+// ForPrefix applies the limit to requests for the given service prefix, e.g.
+// v1/controlPlanes. A prefix limit takes precedence over a base URL limit.
+func ForPrefix(prefix string, l RateLimit) RateLimiterOption {
+	return func(r *RateLimiter) {
+		r.prefixes[normalizePrefix(prefix)] = l
+	}
+}
+
+// WithWaitObserver calls fn with the time every request spent waiting on the
+// RateLimiter, e.g. to record it as a metric. It is called even if the
+// request's context was cancelled while it waited.
+func WithWaitObserver(fn func(req *http.Request, wait time.Duration)) RateLimiterOption {
+	return func(r *RateLimiter) {
+		r.observeWait = fn
+	}
+}
+
+// A RateLimiter limits the rate and concurrency of requests with a token
+// bucket and a semaphore per base URL and service prefix. Limits with a QPS
+// adapt when the server responds with 429 Too Many Requests, and recover as
+// requests succeed again.
+type RateLimiter struct {
+	defaults RateLimit
+	hosts    map[string]RateLimit
+	prefixes map[string]RateLimit
+
+	observeWait func(req *http.Request, wait time.Duration)
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+// NewRateLimiter builds a RateLimiter that applies the default limit to any
+// request without a more specific limit.
+func NewRateLimiter(defaults RateLimit, opts ...RateLimiterOption) *RateLimiter {
+	r := &RateLimiter{
+		defaults: defaults,
+		hosts:    map[string]RateLimit{},
+		prefixes: map[string]RateLimit{},
+		buckets:  map[string]*bucket{},
+	}
+	for _, o := range opts {
+		o(r)
+	}
+	return r
+}
+
+// Wait blocks until the request may be sent. It returns how long the request
+// waited and a function that must be called once the request is complete.
+func (r *RateLimiter) Wait(req *http.Request) (time.Duration, func(), error) {
+	wait, release, err := r.wait(req)
+	if r.observeWait != nil {
+		r.observeWait(req, wait)
+	}
+	return wait, release, err
+}
+
+func (r *RateLimiter) wait(req *http.Request) (time.Duration, func(), error) {
+	b := r.bucket(req)
+	start := time.Now()
+	ctx := req.Context()
+	if b.sem != nil {
+		select {
+		case b.sem <- struct{}{}:
+		case <-ctx.Done():
+			return time.Since(start), nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if b.sem != nil {
+			<-b.sem
+		}
+	}
+	if b.limiter != nil {
+		if err := b.limiter.Wait(ctx); err != nil {
+			release()
+			return time.Since(start), nil, err
+		}
+	}
+	return time.Since(start), release, nil
+}
+
+// Observe adapts the limit applied to the request based on its response. It
+// does nothing for limits without a QPS, since there is no rate to back off
+// from.
+func (r *RateLimiter) Observe(req *http.Request, res *http.Response) {
+	if res == nil {
+		return
+	}
+	b := r.bucket(req)
+	if b.limiter == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch {
+	case res.StatusCode == http.StatusTooManyRequests:
+		b.current = max(b.current/2, b.config.QPS*minAdaptiveFraction)
+	case res.StatusCode < http.StatusMultipleChoices && b.current < b.config.QPS:
+		b.current = min(b.current+b.config.QPS*recoveryFraction, b.config.QPS)
+	default:
+		return
+	}
+	b.limiter.SetLimit(rate.Limit(b.current))
+}
+
+// bucket returns the bucket that limits the request, creating it if needed.
+func (r *RateLimiter) bucket(req *http.Request) *bucket {
+	host := req.URL.Scheme + "://" + req.URL.Host
+	prefix := prefixFromContext(req.Context())
+
+	key, l := host, r.defaults
+	if hl, ok := r.hosts[host]; ok {
+		l = hl
+	}
+	if pl, ok := r.prefixes[prefix]; ok && prefix != "" {
+		key, l = host+"/"+prefix, pl
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if b, ok := r.buckets[key]; ok {
+		return b
+	}
+	b := newBucket(l)
+	r.buckets[key] = b
+	return b
+}
+
+// A bucket holds the limiter state for one base URL or service prefix.
+type bucket struct {
+	config RateLimit
+	sem    chan struct{}
+
+	mu      sync.Mutex
+	limiter *rate.Limiter
+	current float64
+}
+
+func newBucket(l RateLimit) *bucket {
+	b := &bucket{config: l, current: l.QPS}
+	if l.QPS > 0 {
+		b.limiter = rate.NewLimiter(rate.Limit(l.QPS), max(l.Burst, 1))
+	}
+	if l.MaxInFlight > 0 {
+		b.sem = make(chan struct{}, l.MaxInFlight)
+	}
+	return b
+}
+
+// releaseOnClose releases a request's in-flight slot once its response body
+// is closed, so that reading the body counts against the in-flight limit.
+type releaseOnClose struct {
+	io.ReadCloser
+
+	once    sync.Once
+	release func()
+}
+
+func (b *releaseOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+type contextRateLimitWaitType struct{}
+
+var contextRateLimitWaitKey = &contextRateLimitWaitType{} //nolint:gochecknoglobals // This is intended to be global.
+
+// withRateLimitWait records the time a request spent waiting on the client's
+// RateLimiter in the context.
+func withRateLimitWait(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, contextRateLimitWaitKey, d)
+}
+
+// RateLimitWaitFromContext returns the time the request spent waiting on the
+// client's RateLimiter. It is available to transports and zero if the request
+// was not rate limited. Use WithWaitObserver to observe it outside transports.
+func RateLimitWaitFromContext(ctx context.Context) time.Duration {
+	d, _ := ctx.Value(contextRateLimitWaitKey).(time.Duration)
+	return d
+}
+
+type contextPrefixType struct{}
+
+var contextPrefixKey = &contextPrefixType{} //nolint:gochecknoglobals // This is intended to be global.
+
+// withPrefix records the service prefix a request was built for.
+func withPrefix(ctx context.Context, prefix string) context.Context {
+	return context.WithValue(ctx, contextPrefixKey, normalizePrefix(prefix))
+}
+
+// prefixFromContext returns the service prefix a request was built for.
+func prefixFromContext(ctx context.Context) string {
+	p, _ := ctx.Value(contextPrefixKey).(string)
+	return p
+}
+
+func normalizePrefix(prefix string) string {
+	return strings.Trim(prefix, "/")
+}
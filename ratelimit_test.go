@@ -0,0 +1,228 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRateLimiterBucket(t *testing.T) {
+	def := RateLimit{QPS: 1}
+	host := RateLimit{QPS: 2}
+	prefix := RateLimit{QPS: 3}
+	r := NewRateLimiter(def,
+		ForBaseURL("https://api.upbound.io/", host),
+		ForPrefix("/v1/controlPlanes", prefix),
+	)
+
+	cases := map[string]struct {
+		reason string
+		url    string
+		prefix string
+		want   RateLimit
+	}{
+		"Default": {
+			reason: "A request without a specific limit should use the default.",
+			url:    "https://other.upbound.io/v1/repositories",
+			prefix: "v1/repositories",
+			want:   def,
+		},
+		"BaseURL": {
+			reason: "A request to a configured base URL should use its limit.",
+			url:    "https://api.upbound.io/v1/repositories",
+			prefix: "v1/repositories",
+			want:   host,
+		},
+		"Prefix": {
+			reason: "A request for a configured prefix should use its limit.",
+			url:    "https://api.upbound.io/v1/controlPlanes/acct",
+			prefix: "v1/controlPlanes",
+			want:   prefix,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.url, nil)
+			req = req.WithContext(withPrefix(req.Context(), tc.prefix))
+			if diff := cmp.Diff(tc.want, r.bucket(req).config); diff != "" {
+				t.Errorf("\n%s\nbucket(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRateLimiterObserve(t *testing.T) {
+	r := NewRateLimiter(RateLimit{QPS: 10})
+	req := httptest.NewRequest(http.MethodGet, "https://api.upbound.io/v1/self", nil)
+	b := r.bucket(req)
+
+	r.Observe(req, &http.Response{StatusCode: http.StatusTooManyRequests})
+	if diff := cmp.Diff(5.0, b.current); diff != "" {
+		t.Errorf("\nObserve(...): a 429 should halve the limit: -want, +got:\n%s", diff)
+	}
+	for range 10 {
+		r.Observe(req, &http.Response{StatusCode: http.StatusTooManyRequests})
+	}
+	if diff := cmp.Diff(0.5, b.current); diff != "" {
+		t.Errorf("\nObserve(...): the limit should not drop below its floor: -want, +got:\n%s", diff)
+	}
+	r.Observe(req, &http.Response{StatusCode: http.StatusOK})
+	if diff := cmp.Diff(1.5, b.current); diff != "" {
+		t.Errorf("\nObserve(...): a success should recover the limit: -want, +got:\n%s", diff)
+	}
+	for range 20 {
+		r.Observe(req, &http.Response{StatusCode: http.StatusOK})
+	}
+	if diff := cmp.Diff(10.0, b.current); diff != "" {
+		t.Errorf("\nObserve(...): the limit should not exceed its configuration: -want, +got:\n%s", diff)
+	}
+}
+
+func TestRateLimiterObserveUnlimited(t *testing.T) {
+	r := NewRateLimiter(RateLimit{MaxInFlight: 1})
+	req := httptest.NewRequest(http.MethodGet, "https://api.upbound.io/v1/self", nil)
+
+	r.Observe(req, &http.Response{StatusCode: http.StatusTooManyRequests})
+	if b := r.bucket(req); b.limiter != nil {
+		t.Errorf("\nObserve(...): a limit without a QPS should not adapt, got limit %v", b.limiter.Limit())
+	}
+}
+
+func TestRateLimiterMaxInFlight(t *testing.T) {
+	var inFlight, peak int32
+	block := make(chan struct{})
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		<-block
+		atomic.AddInt32(&inFlight, -1)
+	}))
+	defer s.Close()
+
+	u, _ := url.Parse(s.URL)
+	c := NewClient(func(c *HTTPClient) {
+		c.BaseURL = u
+	}, WithRateLimiter(NewRateLimiter(RateLimit{MaxInFlight: 2})))
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := c.NewRequest(context.Background(), http.MethodGet, "v1", "self", nil)
+			_ = c.Do(req, nil)
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(block)
+	wg.Wait()
+
+	if diff := cmp.Diff(int32(2), peak); diff != "" {
+		t.Errorf("\nDo(...): concurrent requests should be capped: -want, +got:\n%s", diff)
+	}
+}
+
+func TestRateLimitWaitRecorded(t *testing.T) {
+	var got []time.Duration
+	c := NewClient(
+		func(c *HTTPClient) {
+			c.HTTP = &http.Client{Transport: roundTripperFn(func(r *http.Request) (*http.Response, error) {
+				got = append(got, RateLimitWaitFromContext(r.Context()))
+				return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+			})}
+		},
+		WithRateLimiter(NewRateLimiter(RateLimit{QPS: 20, Burst: 1})),
+	)
+	for range 2 {
+		req, _ := c.NewRequest(context.Background(), http.MethodGet, "v1", "self", nil)
+		if err := c.Do(req, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(got) != 2 || got[1] < 20*time.Millisecond {
+		t.Errorf("\nDo(...): the second request should record its wait in the context, got %v", got)
+	}
+}
+
+func TestRateLimiterReleaseOnClose(t *testing.T) {
+	l := NewRateLimiter(RateLimit{MaxInFlight: 1})
+	c := NewClient(func(c *HTTPClient) {
+		c.HTTP = &http.Client{Transport: roundTripperFn(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+		})}
+	}, WithRateLimiter(l))
+
+	req, _ := c.NewRequest(context.Background(), http.MethodGet, "v1", "self", nil)
+	res, err := c.do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first response's body is still open, so its slot is still taken.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, _, err := l.Wait(req.WithContext(ctx)); err == nil {
+		t.Errorf("\nWait(...): a request should not be sent while another's response body is open")
+	}
+
+	_ = res.Body.Close()
+	_ = res.Body.Close()
+	_, release, err := l.Wait(req)
+	if err != nil {
+		t.Errorf("\nWait(...): a request should be sent once the response body is closed: %v", err)
+	}
+	release()
+}
+
+func TestWithWaitObserver(t *testing.T) {
+	var got []string
+	l := NewRateLimiter(RateLimit{QPS: 20, Burst: 1}, WithWaitObserver(func(req *http.Request, wait time.Duration) {
+		got = append(got, req.URL.Path)
+	}))
+	c := NewClient(func(c *HTTPClient) {
+		c.HTTP = &http.Client{Transport: roundTripperFn(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		})}
+	}, WithRateLimiter(l))
+	req, _ := c.NewRequest(context.Background(), http.MethodGet, "v1", "self", nil)
+	if err := c.Do(req, nil); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"/v1/self"}, got); diff != "" {
+		t.Errorf("\nDo(...): the observer should be called for every request: -want, +got:\n%s", diff)
+	}
+}
+
+type roundTripperFn func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFn) RoundTrip(r *http.Request) (*http.Response, error) {
+	return fn(r)
+}
@@ -0,0 +1,187 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	uerrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/http/headers"
+)
+
+const (
+	defaultRetryMaxAttempts = 4
+	defaultRetryBaseDelay   = 250 * time.Millisecond
+	defaultRetryMaxDelay    = 10 * time.Second
+	defaultRetryJitter      = 0.2
+)
+
+// A RetryPolicy decides whether a request should be attempted again.
+type RetryPolicy interface {
+	// Retry is called after every attempt with the response or error it
+	// produced. Attempts are numbered from 1. It returns how long to wait
+	// before the next attempt and whether another attempt should be made.
+	Retry(req *http.Request, res *http.Response, err error, attempt int) (time.Duration, bool)
+}
+
+// WithRetryPolicy sets the RetryPolicy used by the client. Requests are not
+// retried when the policy is nil.
+func WithRetryPolicy(p RetryPolicy) ClientModifierFn {
+	return func(c *HTTPClient) {
+		c.RetryPolicy = p
+	}
+}
+
+var _ RetryPolicy = &BackoffRetryPolicy{}
+
+// BackoffRetryPolicy retries transient failures with exponential backoff and
+// jitter, honoring any Retry-After header returned by the server.
+//
+// Only idempotent requests are retried after transport errors or 5xx
+// responses. Other requests, such as POSTs, are retried when they carry an
+// Idempotency-Key header, or when the server rejected them with a 429 before
+// processing them.
+type BackoffRetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the second attempt. It doubles with every
+	// subsequent attempt.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the backoff between attempts.
+	MaxDelay time.Duration
+
+	// Jitter is the fraction, between 0 and 1, by which the backoff is
+	// randomly reduced to avoid synchronized retries.
+	Jitter float64
+}
+
+// NewBackoffRetryPolicy builds a BackoffRetryPolicy with sensible defaults.
+func NewBackoffRetryPolicy() *BackoffRetryPolicy {
+	return &BackoffRetryPolicy{
+		MaxAttempts: defaultRetryMaxAttempts,
+		BaseDelay:   defaultRetryBaseDelay,
+		MaxDelay:    defaultRetryMaxDelay,
+		Jitter:      defaultRetryJitter,
+	}
+}
+
+// Retry implements RetryPolicy.
+func (p *BackoffRetryPolicy) Retry(req *http.Request, res *http.Response, err error, attempt int) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	if req.Context().Err() != nil {
+		return 0, false
+	}
+	switch {
+	case err != nil:
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || !isIdempotent(req) {
+			return 0, false
+		}
+	case res.StatusCode == http.StatusTooManyRequests:
+	case isRetryableStatus(res.StatusCode):
+		if !isIdempotent(req) {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+	if res != nil {
+		if d, ok := uerrors.ParseRetryAfter(res.Header.Get(headers.RetryAfterHeader), time.Now()); ok {
+			return d, true
+		}
+	}
+	return p.backoff(attempt), true
+}
+
+// backoff returns the jittered exponential backoff after the given attempt.
+func (p *BackoffRetryPolicy) backoff(attempt int) time.Duration {
+	d := float64(p.BaseDelay) * math.Pow(2, float64(attempt-1))
+	if p.MaxDelay > 0 && d > float64(p.MaxDelay) {
+		d = float64(p.MaxDelay)
+	}
+	if p.Jitter > 0 {
+		d -= d * p.Jitter * rand.Float64() //nolint:gosec // Jitter does not need a cryptographically secure source.
+	}
+	return time.Duration(d)
+}
+
+// isIdempotent returns true if repeating the request has the same effect as
+// sending it once.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return req.Header.Get(headers.IdempotencyKeyHeader) != ""
+}
+
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// rewind resets the request body so that the request can be sent again. It
+// returns false if the body cannot be reset.
+func rewind(req *http.Request) bool {
+	if req.Body == nil || req.Body == http.NoBody {
+		return true
+	}
+	if req.GetBody == nil {
+		return false
+	}
+	b, err := req.GetBody()
+	if err != nil {
+		return false
+	}
+	req.Body = b
+	return true
+}
+
+// drain discards and closes the response body so that the underlying
+// connection can be reused.
+func drain(res *http.Response) {
+	if res == nil || res.Body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 4<<10))
+	_ = res.Body.Close()
+}
+
+// sleep waits for d or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/upbound/up-sdk-go/http/headers"
+)
+
+func TestBackoffRetryPolicy(t *testing.T) {
+	p := &BackoffRetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: 1500 * time.Millisecond}
+	errBoom := errors.New("boom")
+
+	type args struct {
+		method  string
+		header  http.Header
+		res     *http.Response
+		err     error
+		attempt int
+	}
+	type want struct {
+		wait  time.Duration
+		retry bool
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Success": {
+			reason: "A successful response should not be retried.",
+			args:   args{method: http.MethodGet, res: &http.Response{StatusCode: http.StatusOK}, attempt: 1},
+		},
+		"ClientError": {
+			reason: "A client error should not be retried.",
+			args:   args{method: http.MethodGet, res: &http.Response{StatusCode: http.StatusNotFound}, attempt: 1},
+		},
+		"IdempotentServiceUnavailable": {
+			reason: "An idempotent request should be retried after a 503 with backoff.",
+			args:   args{method: http.MethodGet, res: &http.Response{StatusCode: http.StatusServiceUnavailable}, attempt: 1},
+			want:   want{wait: time.Second, retry: true},
+		},
+		"BackoffCapped": {
+			reason: "The backoff should not exceed the maximum delay.",
+			args:   args{method: http.MethodGet, res: &http.Response{StatusCode: http.StatusBadGateway}, attempt: 2},
+			want:   want{wait: 1500 * time.Millisecond, retry: true},
+		},
+		"MaxAttempts": {
+			reason: "No further attempts should be made once the maximum is reached.",
+			args:   args{method: http.MethodGet, res: &http.Response{StatusCode: http.StatusServiceUnavailable}, attempt: 3},
+		},
+		"PostServiceUnavailable": {
+			reason: "A POST should not be retried after a 503 since it may have been processed.",
+			args:   args{method: http.MethodPost, res: &http.Response{StatusCode: http.StatusServiceUnavailable}, attempt: 1},
+		},
+		"PostIdempotencyKey": {
+			reason: "A POST carrying an idempotency key is safe to retry.",
+			args: args{
+				method:  http.MethodPost,
+				header:  http.Header{headers.IdempotencyKeyHeader: []string{"key"}},
+				res:     &http.Response{StatusCode: http.StatusServiceUnavailable},
+				attempt: 1,
+			},
+			want: want{wait: time.Second, retry: true},
+		},
+		"PostTooManyRequests": {
+			reason: "A POST rejected with a 429 was not processed and is safe to retry after Retry-After.",
+			args: args{
+				method:  http.MethodPost,
+				res:     &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{headers.RetryAfterHeader: []string{"7"}}},
+				attempt: 1,
+			},
+			want: want{wait: 7 * time.Second, retry: true},
+		},
+		"TransportError": {
+			reason: "An idempotent request should be retried after a transport error.",
+			args:   args{method: http.MethodDelete, err: errBoom, attempt: 1},
+			want:   want{wait: time.Second, retry: true},
+		},
+		"PostTransportError": {
+			reason: "A POST should not be retried after a transport error.",
+			args:   args{method: http.MethodPost, err: errBoom, attempt: 1},
+		},
+		"Canceled": {
+			reason: "A canceled request should not be retried.",
+			args:   args{method: http.MethodGet, err: context.Canceled, attempt: 1},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.args.method, "https://api.upbound.io", nil)
+			for k, v := range tc.args.header {
+				req.Header[k] = v
+			}
+			if tc.args.res != nil && tc.args.res.Header == nil {
+				tc.args.res.Header = http.Header{}
+			}
+			wait, retry := p.Retry(req, tc.args.res, tc.args.err, tc.args.attempt)
+			if diff := cmp.Diff(tc.want, want{wait: wait, retry: retry}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nRetry(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDoRetries(t *testing.T) {
+	type want struct {
+		attempts int
+		bodies   []string
+		err      bool
+	}
+	cases := map[string]struct {
+		reason   string
+		method   string
+		body     interface{}
+		statuses []int
+		want     want
+	}{
+		"RetriedUntilSuccess": {
+			reason:   "A GET should be retried until it succeeds.",
+			method:   http.MethodGet,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			want:     want{attempts: 3, bodies: []string{"", "", ""}},
+		},
+		"BodyRewound": {
+			reason:   "The request body should be sent again on every attempt.",
+			method:   http.MethodPut,
+			body:     map[string]string{"a": "b"},
+			statuses: []int{http.StatusServiceUnavailable, http.StatusOK},
+			want:     want{attempts: 2, bodies: []string{"{\"a\":\"b\"}\n", "{\"a\":\"b\"}\n"}},
+		},
+		"PostNotRetried": {
+			reason:   "A POST should not be retried after a 503.",
+			method:   http.MethodPost,
+			body:     map[string]string{"a": "b"},
+			statuses: []int{http.StatusServiceUnavailable, http.StatusOK},
+			want:     want{attempts: 1, bodies: []string{"{\"a\":\"b\"}\n"}, err: true},
+		},
+		"GiveUp": {
+			reason:   "The last error should be returned once attempts are exhausted.",
+			method:   http.MethodGet,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			want:     want{attempts: 3, bodies: []string{"", "", ""}, err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got want
+			ids := map[string]bool{}
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, _ := io.ReadAll(r.Body)
+				got.bodies = append(got.bodies, string(b))
+				ids[r.Header.Get(headers.RequestIDHeader)] = true
+				w.WriteHeader(tc.statuses[got.attempts])
+				got.attempts++
+			}))
+			defer s.Close()
+			u, _ := url.Parse(s.URL)
+			c := NewClient(func(c *HTTPClient) {
+				c.BaseURL = u
+			}, WithRetryPolicy(&BackoffRetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+			req, err := c.NewRequest(context.Background(), tc.method, "v1", "test", tc.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got.err = c.Do(req, nil) != nil
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nDo(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if len(ids) != 1 {
+				t.Errorf("\n%s\nDo(...): every attempt should share a request ID, got %v", tc.reason, ids)
+			}
+		})
+	}
+}
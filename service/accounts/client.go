@@ -19,6 +19,7 @@ import (
 	"net/http"
 
 	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/organizations"
 )
 
 const basePath = "v1/accounts"
@@ -26,13 +27,15 @@ const basePath = "v1/accounts"
 // Client is a accounts client.
 type Client struct {
 	*up.Config
+
+	orgs *organizations.Client
 }
 
-// NewClient builds a accounts client from the passed config.
-func NewClient(cfg *up.Config) *Client {
-	return &Client{
-		cfg,
-	}
+// NewClient builds a accounts client from the passed config. Options
+// configure the organizations client used to resolve organization names to
+// IDs, e.g. to share its Resolver.
+func NewClient(cfg *up.Config, opts ...organizations.ClientOption) *Client {
+	return &Client{Config: cfg, orgs: organizations.NewClient(cfg, opts...)}
 }
 
 // Get a account on Upbound.
@@ -62,3 +65,9 @@ func (c *Client) List(ctx context.Context) ([]AccountResponse, error) {
 	}
 	return ns, nil
 }
+
+// GetOrgID returns the ID of the organization with the given name. IDs are
+// cached, so repeated calls do not list every organization.
+func (c *Client) GetOrgID(ctx context.Context, name string) (uint, error) {
+	return c.orgs.GetOrgID(ctx, name)
+}
@@ -16,6 +16,7 @@ package accounts
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -24,6 +25,7 @@ import (
 
 	"github.com/upbound/up-sdk-go"
 	"github.com/upbound/up-sdk-go/fake"
+	"github.com/upbound/up-sdk-go/service/organizations"
 )
 
 func TestGet(t *testing.T) {
@@ -132,3 +134,53 @@ func TestList(t *testing.T) {
 		})
 	}
 }
+
+func TestGetOrgID(t *testing.T) {
+	lists := 0
+	cfg := &up.Config{
+		Client: &fake.MockClient{
+			MockNewRequest: fake.NewMockNewRequestFn(nil, nil),
+			MockDo: func(_ *http.Request, obj interface{}) error {
+				lists++
+				*obj.(*[]organizations.Organization) = []organizations.Organization{{ID: 1, Name: "acme"}}
+				return nil
+			},
+		},
+	}
+	r := organizations.NewOrgIDResolver(cfg)
+	type want struct {
+		id  uint
+		err bool
+	}
+	cases := map[string]struct {
+		reason string
+		name   string
+		want   want
+	}{
+		"Organization": {
+			reason: "The ID of an organization should be returned.",
+			name:   "acme",
+			want:   want{id: 1},
+		},
+		"Missing": {
+			reason: "An unknown organization should return an error.",
+			name:   "someone",
+			want:   want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, err := NewClient(cfg, organizations.WithOrgIDResolver(r)).GetOrgID(context.Background(), tc.name)
+			if diff := cmp.Diff(tc.want, want{id: id, err: err != nil}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nGetOrgID(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+	lists = 0
+	if _, err := NewClient(cfg, organizations.WithOrgIDResolver(r)).GetOrgID(context.Background(), "acme"); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(0, lists); diff != "" {
+		t.Errorf("\nGetOrgID(...): clients sharing a resolver should share its cache: -want lists, +got lists:\n%s", diff)
+	}
+}
@@ -44,8 +44,14 @@ func NewClient(cfg *up.Config) *Client {
 // GetOrgScopedToken returns a token scoped to a specific organization on
 // Upbound, which can be used with spaces and control planes.
 func (c *Client) GetOrgScopedToken(ctx context.Context, org, token string) (*TokenExchangeResponse, error) { // nolint:interfacer
+	return c.ExchangeToken(ctx, org, token, AudienceSpacesAPI, AudienceSpacesControlPlanes)
+}
+
+// ExchangeToken exchanges the supplied token for a token scoped to a specific
+// organization on Upbound and granted the supplied audiences.
+func (c *Client) ExchangeToken(ctx context.Context, org, token string, audiences ...string) (*TokenExchangeResponse, error) {
 	body := url.Values{
-		ParamAudience:         []string{AudienceSpacesAPI, AudienceSpacesControlPlanes},
+		ParamAudience:         audiences,
 		ParamGrantType:        []string{GrantTypeTokenExchange},
 		ParamSubjectTokenType: []string{TokenTypeIDToken},
 		ParamSubjectToken:     []string{token},
@@ -57,12 +63,12 @@ func (c *Client) GetOrgScopedToken(ctx context.Context, org, token string) (*Tok
 		return nil, err
 	}
 
-	reader := strings.NewReader(body.Encode())
+	encoded := body.Encode()
 
-	req.ContentLength = int64(reader.Len())
-	req.Body = io.NopCloser(reader)
+	req.ContentLength = int64(len(encoded))
+	req.Body = io.NopCloser(strings.NewReader(encoded))
 	req.GetBody = func() (io.ReadCloser, error) {
-		return io.NopCloser(reader), nil
+		return io.NopCloser(strings.NewReader(encoded)), nil
 	}
 	req.Header.Set("Content-Type", ContentTypeFormURLEncoded)
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
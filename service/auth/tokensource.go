@@ -0,0 +1,234 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/upbound/up-sdk-go"
+)
+
+const (
+	// DefaultRefreshBefore is how long before expiry a cached token is
+	// refreshed by default.
+	DefaultRefreshBefore = time.Minute
+
+	// exchangeTimeout bounds an exchange, which is detached from the
+	// cancellation of the callers waiting on it.
+	exchangeTimeout = 30 * time.Second
+
+	errExchange = "cannot exchange token"
+)
+
+// An Exchanger exchanges a token for an org-scoped token. It is satisfied by
+// *Client.
+type Exchanger interface {
+	ExchangeToken(ctx context.Context, org, token string, audiences ...string) (*TokenExchangeResponse, error)
+}
+
+// A TokenSourceOption modifies a TokenSource.
+type TokenSourceOption func(*TokenSource)
+
+// WithRefreshBefore sets how long before expiry a cached token is refreshed.
+func WithRefreshBefore(d time.Duration) TokenSourceOption {
+	return func(s *TokenSource) {
+		s.refreshBefore = d
+	}
+}
+
+// WithClock sets the function used to tell the current time.
+func WithClock(now func() time.Time) TokenSourceOption {
+	return func(s *TokenSource) {
+		s.now = now
+	}
+}
+
+// A TokenSource exchanges a subject token for org-scoped tokens, caching them
+// per org and audience set and refreshing them shortly before they expire. It
+// is safe for concurrent use, and concurrent requests for the same token share
+// a single exchange.
+type TokenSource struct {
+	exchanger     Exchanger
+	subject       string
+	refreshBefore time.Duration
+	now           func() time.Time
+
+	group singleflight.Group
+
+	mu     sync.Mutex
+	tokens map[string]cachedToken
+}
+
+type cachedToken struct {
+	token  string
+	expiry time.Time
+}
+
+// NewTokenSource builds a TokenSource that exchanges the supplied subject
+// token, e.g. a session or ID token, using the supplied Exchanger.
+func NewTokenSource(e Exchanger, subject string, opts ...TokenSourceOption) *TokenSource {
+	s := &TokenSource{
+		exchanger:     e,
+		subject:       subject,
+		refreshBefore: DefaultRefreshBefore,
+		now:           time.Now,
+		tokens:        map[string]cachedToken{},
+	}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
+}
+
+// Token returns a token for the org granted the supplied audiences. The
+// audiences used by GetOrgScopedToken are granted if none are supplied. A
+// cached token that is about to expire is returned while a new one is
+// exchanged in the background; Token only waits for an exchange once the
+// cached token has expired.
+func (s *TokenSource) Token(ctx context.Context, org string, audiences ...string) (string, error) {
+	if len(audiences) == 0 {
+		audiences = []string{AudienceSpacesAPI, AudienceSpacesControlPlanes}
+	}
+	key := cacheKey(org, audiences)
+	t, fresh, valid := s.cached(key)
+	if fresh {
+		return t, nil
+	}
+	ch := s.exchange(ctx, key, org, audiences)
+	if valid {
+		return t, nil
+	}
+	select {
+	case r := <-ch:
+		if r.Err != nil {
+			return "", r.Err
+		}
+		return r.Val.(string), nil //nolint:forcetypeassert // Always a string.
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// exchange exchanges a new token for the key, sharing the exchange with any
+// that is already in flight. The exchange is detached from the cancellation of
+// ctx so that a caller giving up does not fail the other callers waiting on it.
+func (s *TokenSource) exchange(ctx context.Context, key, org string, audiences []string) <-chan singleflight.Result {
+	ctx = context.WithoutCancel(ctx)
+	return s.group.DoChan(key, func() (any, error) {
+		// Another caller may have refreshed the token while we waited.
+		if t, fresh, _ := s.cached(key); fresh {
+			return t, nil
+		}
+		ctx, cancel := context.WithTimeout(ctx, exchangeTimeout)
+		defer cancel()
+		res, err := s.exchanger.ExchangeToken(ctx, org, s.subject, audiences...)
+		if err != nil {
+			return "", errors.Wrap(err, errExchange)
+		}
+		if res.ExpiresIn > 0 {
+			s.mu.Lock()
+			s.tokens[key] = cachedToken{
+				token:  res.AccessToken,
+				expiry: s.now().Add(time.Duration(res.ExpiresIn) * time.Second),
+			}
+			s.mu.Unlock()
+		}
+		return res.AccessToken, nil
+	})
+}
+
+// Invalidate drops the cached token for the org and audiences, forcing the
+// next call to Token to exchange a new one.
+func (s *TokenSource) Invalidate(org string, audiences ...string) {
+	if len(audiences) == 0 {
+		audiences = []string{AudienceSpacesAPI, AudienceSpacesControlPlanes}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tokens, cacheKey(org, audiences))
+}
+
+// CredentialProvider returns an up.CredentialProvider that authenticates
+// requests with a token for the org and audiences. Requests that already carry
+// an Authorization header, such as the token exchange itself, are left alone.
+func (s *TokenSource) CredentialProvider(org string, audiences ...string) up.CredentialProvider {
+	return up.CredentialProviderFn(func(req *http.Request) error {
+		if req.Header.Get("Authorization") != "" {
+			return nil
+		}
+		t, err := s.Token(req.Context(), org, audiences...)
+		if err != nil {
+			return err
+		}
+		return (&up.StaticTokenProvider{Token: t}).Authenticate(req)
+	})
+}
+
+// RoundTripper returns an http.RoundTripper that authenticates requests with
+// a token for the org and audiences before sending them with base. The
+// http.DefaultTransport is used if base is nil.
+func (s *TokenSource) RoundTripper(base http.RoundTripper, org string, audiences ...string) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{Base: base, Credentials: s.CredentialProvider(org, audiences...)}
+}
+
+// Transport is an http.RoundTripper that authenticates requests before
+// sending them with Base.
+type Transport struct {
+	Base        http.RoundTripper
+	Credentials up.CredentialProvider
+}
+
+// RoundTrip authenticates a copy of the request and sends it.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	if err := t.Credentials.Authenticate(req); err != nil {
+		return nil, err
+	}
+	return t.Base.RoundTrip(req)
+}
+
+// cached returns the cached token for the key, whether it is fresh, and
+// whether it is still valid. A valid token that is not fresh is about to
+// expire and should be refreshed.
+func (s *TokenSource) cached(key string) (token string, fresh, valid bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tokens[key]
+	if !ok {
+		return "", false, false
+	}
+	now := s.now()
+	return t.token, now.Add(s.refreshBefore).Before(t.expiry), now.Before(t.expiry)
+}
+
+// cacheKey identifies the token for an org and audience set, regardless of
+// the order of the audiences.
+func cacheKey(org string, audiences []string) string {
+	a := slices.Clone(audiences)
+	slices.Sort(a)
+	return fmt.Sprintf("%s/%s", org, strings.Join(slices.Compact(a), ","))
+}
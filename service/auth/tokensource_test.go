@@ -0,0 +1,249 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+// fakeExchanger issues numbered tokens, blocking each exchange until release
+// is closed if it is set.
+type fakeExchanger struct {
+	calls     atomic.Int32
+	expiresIn int
+	release   chan struct{}
+	err       error
+}
+
+func (f *fakeExchanger) ExchangeToken(_ context.Context, org, _ string, audiences ...string) (*TokenExchangeResponse, error) {
+	n := f.calls.Add(1)
+	if f.release != nil {
+		<-f.release
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &TokenExchangeResponse{AccessToken: fmt.Sprintf("%s-%d-%d", org, len(audiences), n), ExpiresIn: f.expiresIn}, nil
+}
+
+func TestTokenSource(t *testing.T) {
+	errBoom := errors.New("boom")
+	type call struct {
+		after     time.Duration
+		org       string
+		audiences []string
+	}
+	type want struct {
+		tokens []string
+		calls  int32
+		err    error
+	}
+	cases := map[string]struct {
+		reason    string
+		expiresIn int
+		err       error
+		calls     []call
+		want      want
+	}{
+		"Cached": {
+			reason:    "A token should be reused until shortly before it expires.",
+			expiresIn: 300,
+			calls:     []call{{org: "acme"}, {after: 3 * time.Minute, org: "acme"}},
+			want:      want{tokens: []string{"acme-2-1", "acme-2-1"}, calls: 1},
+		},
+		"Expired": {
+			reason:    "A new token should be exchanged once the cached one has expired.",
+			expiresIn: 300,
+			calls:     []call{{org: "acme"}, {after: 5 * time.Minute, org: "acme"}},
+			want:      want{tokens: []string{"acme-2-1", "acme-2-2"}, calls: 2},
+		},
+		"PerOrgAndAudience": {
+			reason:    "Tokens should be cached per org and audience set, regardless of audience order.",
+			expiresIn: 300,
+			calls: []call{
+				{org: "acme", audiences: []string{AudienceSpacesAPI, AudienceSpacesControlPlanes}},
+				{org: "acme", audiences: []string{AudienceSpacesControlPlanes, AudienceSpacesAPI}},
+				{org: "acme", audiences: []string{AudienceSpacesAPI}},
+				{org: "other"},
+			},
+			want: want{tokens: []string{"acme-2-1", "acme-2-1", "acme-1-2", "other-2-3"}, calls: 3},
+		},
+		"NoExpiry": {
+			reason: "A token without an expiry should not be cached.",
+			calls:  []call{{org: "acme"}, {org: "acme"}},
+			want:   want{tokens: []string{"acme-2-1", "acme-2-2"}, calls: 2},
+		},
+		"Error": {
+			reason: "An error exchanging the token should be returned.",
+			err:    errBoom,
+			calls:  []call{{org: "acme"}},
+			want:   want{tokens: []string{""}, calls: 1, err: errors.Wrap(errBoom, errExchange)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			e := &fakeExchanger{expiresIn: tc.expiresIn, err: tc.err}
+			s := NewTokenSource(e, "subject", WithClock(func() time.Time { return now }))
+			var got want
+			for _, c := range tc.calls {
+				now = now.Add(c.after)
+				tok, err := s.Token(context.Background(), c.org, c.audiences...)
+				got.tokens = append(got.tokens, tok)
+				if err != nil {
+					got.err = err
+				}
+			}
+			got.calls = e.calls.Load()
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nToken(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTokenSourceConcurrent(t *testing.T) {
+	e := &fakeExchanger{expiresIn: 300, release: make(chan struct{})}
+	s := NewTokenSource(e, "subject")
+
+	var wg sync.WaitGroup
+	tokens := make([]string, 10)
+	for i := range tokens {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tokens[i], _ = s.Token(context.Background(), "acme")
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(e.release)
+	wg.Wait()
+
+	if diff := cmp.Diff(int32(1), e.calls.Load()); diff != "" {
+		t.Errorf("\nToken(...): concurrent refreshes should be deduplicated: -want, +got:\n%s", diff)
+	}
+	for _, tok := range tokens {
+		if tok != "acme-2-1" {
+			t.Errorf("\nToken(...): every caller should get the same token, got %v", tokens)
+			break
+		}
+	}
+}
+
+func TestTokenSourceRefreshesEarly(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := &fakeExchanger{expiresIn: 300}
+	s := NewTokenSource(e, "subject", WithClock(func() time.Time { return now }))
+	if _, err := s.Token(context.Background(), "acme"); err != nil {
+		t.Fatal(err)
+	}
+
+	now = now.Add(4*time.Minute + time.Second)
+	tok, err := s.Token(context.Background(), "acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("acme-2-1", tok); diff != "" {
+		t.Errorf("\nToken(...): a token about to expire should be returned while it is refreshed: -want, +got:\n%s", diff)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if tok, fresh, _ := s.cached(cacheKey("acme", []string{AudienceSpacesAPI, AudienceSpacesControlPlanes})); fresh {
+			if diff := cmp.Diff("acme-2-2", tok); diff != "" {
+				t.Errorf("\nToken(...): -want refreshed token, +got refreshed token:\n%s", diff)
+			}
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("\nToken(...): timed out waiting for the token to be refreshed")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestTokenSourceCallerCancelled(t *testing.T) {
+	e := &fakeExchanger{expiresIn: 300, release: make(chan struct{})}
+	s := NewTokenSource(e, "subject")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+	go func() {
+		_, err := s.Token(ctx, "acme")
+		first <- err
+	}()
+	for e.calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	second := make(chan string)
+	go func() {
+		tok, _ := s.Token(context.Background(), "acme")
+		second <- tok
+	}()
+
+	cancel()
+	if diff := cmp.Diff(context.Canceled, <-first, test.EquateErrors()); diff != "" {
+		t.Errorf("\nToken(...): a cancelled caller should stop waiting: -want, +got:\n%s", diff)
+	}
+	close(e.release)
+	if diff := cmp.Diff("acme-2-1", <-second); diff != "" {
+		t.Errorf("\nToken(...): other callers should not be failed by a cancelled caller: -want, +got:\n%s", diff)
+	}
+}
+
+func TestTokenSourceCredentialProvider(t *testing.T) {
+	e := &fakeExchanger{expiresIn: 300}
+	s := NewTokenSource(e, "subject")
+	req, _ := http.NewRequest(http.MethodPost, "https://api.upbound.io", nil)
+	req.Header.Set("Authorization", "Bearer subject")
+	if err := s.CredentialProvider("acme").Authenticate(req); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("Bearer subject", req.Header.Get("Authorization")); diff != "" {
+		t.Errorf("\nAuthenticate(...): an existing Authorization header should be kept: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(int32(0), e.calls.Load()); diff != "" {
+		t.Errorf("\nAuthenticate(...): no token should be exchanged: -want, +got:\n%s", diff)
+	}
+}
+
+func TestTokenSourceRoundTripper(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+	}))
+	defer srv.Close()
+
+	s := NewTokenSource(&fakeExchanger{expiresIn: 300}, "subject")
+	c := &http.Client{Transport: s.RoundTripper(nil, "acme", AudienceSpacesAPI)}
+	res, err := c.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close() //nolint:errcheck // Nothing to do with the error.
+	if diff := cmp.Diff("Bearer acme-1-1", got); diff != "" {
+		t.Errorf("\nRoundTrip(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"iter"
+)
+
+// A PageFunc fetches a single page of items using the supplied list options.
+// It returns the items on the page and the page size reported by the server.
+type PageFunc[T any] func(ctx context.Context, opts ...ListOption) ([]T, int, error)
+
+// A Pager iterates over every item returned by a paginated list endpoint.
+type Pager[T any] struct {
+	fetch PageFunc[T]
+	opts  []ListOption
+}
+
+// NewPager builds a Pager that fetches pages with the supplied function. The
+// list options are applied to every page request. The page itself is set by
+// the Pager and must not be supplied.
+func NewPager[T any](fetch PageFunc[T], opts ...ListOption) *Pager[T] {
+	return &Pager[T]{
+		fetch: fetch,
+		opts:  opts,
+	}
+}
+
+// All returns an iterator over the items of every page, starting from the
+// first. Pages are fetched as the iterator advances. Iteration stops after the
+// last page, or yields an error and stops if a page cannot be fetched or ctx
+// is done.
+func (p *Pager[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for page := 0; ; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			opts := make([]ListOption, 0, len(p.opts)+1)
+			opts = append(opts, p.opts...)
+			opts = append(opts, WithPage(page))
+			items, size, err := p.fetch(ctx, opts...)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, i := range items {
+				if !yield(i, nil) {
+					return
+				}
+			}
+			if len(items) == 0 || len(items) < size {
+				return
+			}
+		}
+	}
+}
+
+// Collect fetches every page and returns all items.
+func (p *Pager[T]) Collect(ctx context.Context) ([]T, error) {
+	var all []T
+	for i, err := range p.All(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, i)
+	}
+	return all, nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+)
+
+// fakePages serves the supplied pages, recording the query of each request.
+func fakePages(pages [][]int, size int, queries *[]string, err error) PageFunc[int] {
+	return func(ctx context.Context, opts ...ListOption) ([]int, int, error) {
+		r, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://localhost:8080", nil)
+		for _, o := range opts {
+			o(r)
+		}
+		*queries = append(*queries, r.URL.RawQuery)
+		page, _ := strconv.Atoi(r.URL.Query().Get(PageParam))
+		if page >= len(pages) {
+			return nil, size, err
+		}
+		return pages[page], size, nil
+	}
+}
+
+func TestPagerAll(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		items   []int
+		queries []string
+		err     error
+	}
+	cases := map[string]struct {
+		reason string
+		pages  [][]int
+		size   int
+		opts   []ListOption
+		take   int
+		err    error
+		want   want
+	}{
+		"ShortLastPage": {
+			reason: "Iteration should stop after a page with fewer items than the page size.",
+			pages:  [][]int{{1, 2}, {3}},
+			size:   2,
+			want: want{
+				items:   []int{1, 2, 3},
+				queries: []string{"page=0", "page=1"},
+			},
+		},
+		"EmptyLastPage": {
+			reason: "Iteration should stop after an empty page.",
+			pages:  [][]int{{1, 2}, {3, 4}},
+			size:   2,
+			want: want{
+				items:   []int{1, 2, 3, 4},
+				queries: []string{"page=0", "page=1", "page=2"},
+			},
+		},
+		"ListOptions": {
+			reason: "List options should be applied to every page.",
+			pages:  [][]int{{1}},
+			size:   2,
+			opts:   []ListOption{WithSize(2)},
+			want: want{
+				items:   []int{1},
+				queries: []string{"page=0&size=2"},
+			},
+		},
+		"Break": {
+			reason: "No more pages should be fetched once the caller stops iterating.",
+			pages:  [][]int{{1, 2}, {3, 4}},
+			size:   2,
+			take:   1,
+			want: want{
+				items:   []int{1},
+				queries: []string{"page=0"},
+			},
+		},
+		"Error": {
+			reason: "An error fetching a page should be yielded and stop iteration.",
+			pages:  [][]int{{1, 2}},
+			size:   2,
+			err:    errBoom,
+			want: want{
+				items:   []int{1, 2},
+				queries: []string{"page=0", "page=1"},
+				err:     errBoom,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got want
+			p := NewPager(fakePages(tc.pages, tc.size, &got.queries, tc.err), tc.opts...)
+			for i, err := range p.All(context.Background()) {
+				if err != nil {
+					got.err = err
+					continue
+				}
+				got.items = append(got.items, i)
+				if tc.take > 0 && len(got.items) == tc.take {
+					break
+				}
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nAll(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPagerCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var queries []string
+	p := NewPager(fakePages([][]int{{1, 2}, {3, 4}}, 2, &queries, nil))
+
+	var items []int
+	var err error
+	for i, e := range p.All(ctx) {
+		if e != nil {
+			err = e
+			continue
+		}
+		items = append(items, i)
+		cancel()
+	}
+	if diff := cmp.Diff([]int{1, 2}, items); diff != "" {
+		t.Errorf("\nAll(...): no further pages should be fetched after cancellation: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(context.Canceled, err, cmpopts.EquateErrors()); diff != "" {
+		t.Errorf("\nAll(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestPagerCollect(t *testing.T) {
+	var queries []string
+	got, err := NewPager(fakePages([][]int{{1, 2}, {3}}, 2, &queries, nil)).Collect(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]int{1, 2, 3}, got); diff != "" {
+		t.Errorf("\nCollect(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// DefaultResolverTTL is how long resolved names are cached by default.
+	DefaultResolverTTL = 5 * time.Minute
+
+	// resolveTimeout bounds the listing of a scope, which is detached from
+	// the cancellation of the callers waiting on it.
+	resolveTimeout = time.Minute
+)
+
+// A ResolveFunc returns every name in the supplied scope, e.g. the teams of an
+// organization, mapped to its ID.
+type ResolveFunc[V any] func(ctx context.Context, scope string) (map[string]V, error)
+
+// A ResolverOption modifies a Resolver.
+type ResolverOption func(*resolverOptions)
+
+type resolverOptions struct {
+	ttl time.Duration
+	now func() time.Time
+}
+
+// WithResolverTTL sets how long resolved names are cached.
+func WithResolverTTL(d time.Duration) ResolverOption {
+	return func(o *resolverOptions) {
+		o.ttl = d
+	}
+}
+
+// WithResolverClock sets the function used to tell the current time.
+func WithResolverClock(now func() time.Time) ResolverOption {
+	return func(o *resolverOptions) {
+		o.now = now
+	}
+}
+
+// A Resolver resolves names to IDs, caching every name in a scope for a TTL
+// so that repeated lookups do not list the scope again. A name that is not
+// cached causes the scope to be listed again, so newly created entities are
+// found. It is safe for concurrent use, and concurrent lookups in the same
+// scope share a single listing.
+type Resolver[V any] struct {
+	fetch ResolveFunc[V]
+	opts  resolverOptions
+	group singleflight.Group
+
+	mu     sync.Mutex
+	scopes map[string]resolved[V]
+}
+
+type resolved[V any] struct {
+	ids    map[string]V
+	expiry time.Time
+}
+
+// NewResolver builds a Resolver that lists the names in a scope with the
+// supplied function.
+func NewResolver[V any](fetch ResolveFunc[V], opts ...ResolverOption) *Resolver[V] {
+	r := &Resolver[V]{
+		fetch:  fetch,
+		opts:   resolverOptions{ttl: DefaultResolverTTL, now: time.Now},
+		scopes: map[string]resolved[V]{},
+	}
+	for _, o := range opts {
+		o(&r.opts)
+	}
+	return r
+}
+
+// Resolve returns the ID of the name in the scope. It returns false if there
+// is no such name. The scope is listed on a context detached from the
+// cancellation of ctx, so that a caller giving up does not fail the other
+// callers waiting on the same listing.
+func (r *Resolver[V]) Resolve(ctx context.Context, scope, name string) (V, bool, error) {
+	if id, ok := r.cached(scope, name); ok {
+		return id, true, nil
+	}
+	fctx := context.WithoutCancel(ctx)
+	ch := r.group.DoChan(scope, func() (any, error) {
+		fctx, cancel := context.WithTimeout(fctx, resolveTimeout)
+		defer cancel()
+		ids, err := r.fetch(fctx, scope)
+		if err != nil {
+			return nil, err
+		}
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.scopes[scope] = resolved[V]{ids: ids, expiry: r.opts.now().Add(r.opts.ttl)}
+		return ids, nil
+	})
+	var zero V
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return zero, false, res.Err
+		}
+		id, ok := res.Val.(map[string]V)[name]
+		return id, ok, nil
+	case <-ctx.Done():
+		return zero, false, ctx.Err()
+	}
+}
+
+// Invalidate drops the cached names in the scope, e.g. after one of them is
+// renamed or deleted.
+func (r *Resolver[V]) Invalidate(scope string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.scopes, scope)
+}
+
+// Reset drops every cached name.
+func (r *Resolver[V]) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.scopes = map[string]resolved[V]{}
+}
+
+func (r *Resolver[V]) cached(scope, name string) (V, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.scopes[scope]
+	if !ok || !r.opts.now().Before(s.expiry) {
+		var zero V
+		return zero, false
+	}
+	id, ok := s.ids[name]
+	return id, ok
+}
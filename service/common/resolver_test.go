@@ -0,0 +1,145 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+)
+
+func TestResolver(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type lookup struct {
+		after time.Duration
+		scope string
+		name  string
+	}
+	type result struct {
+		id int
+		ok bool
+	}
+	type want struct {
+		results []result
+		fetches []string
+		err     error
+	}
+	cases := map[string]struct {
+		reason  string
+		err     error
+		lookups []lookup
+		want    want
+	}{
+		"Cached": {
+			reason:  "Names should be resolved from the cache until the TTL passes.",
+			lookups: []lookup{{scope: "a", name: "one"}, {after: 4 * time.Minute, scope: "a", name: "two"}},
+			want: want{
+				results: []result{{id: 1, ok: true}, {id: 2, ok: true}},
+				fetches: []string{"a"},
+			},
+		},
+		"Expired": {
+			reason:  "Names should be listed again once the TTL passes.",
+			lookups: []lookup{{scope: "a", name: "one"}, {after: 5 * time.Minute, scope: "a", name: "one"}},
+			want: want{
+				results: []result{{id: 1, ok: true}, {id: 1, ok: true}},
+				fetches: []string{"a", "a"},
+			},
+		},
+		"PerScope": {
+			reason:  "Names should be cached per scope.",
+			lookups: []lookup{{scope: "a", name: "one"}, {scope: "b", name: "one"}, {scope: "a", name: "two"}},
+			want: want{
+				results: []result{{id: 1, ok: true}, {id: 1, ok: true}, {id: 2, ok: true}},
+				fetches: []string{"a", "b"},
+			},
+		},
+		"Missing": {
+			reason:  "A name that is not cached should cause the scope to be listed again.",
+			lookups: []lookup{{scope: "a", name: "one"}, {scope: "a", name: "three"}},
+			want: want{
+				results: []result{{id: 1, ok: true}, {}},
+				fetches: []string{"a", "a"},
+			},
+		},
+		"Error": {
+			reason:  "An error listing the scope should be returned.",
+			err:     errBoom,
+			lookups: []lookup{{scope: "a", name: "one"}},
+			want: want{
+				results: []result{{}},
+				fetches: []string{"a"},
+				err:     errBoom,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			var got want
+			r := NewResolver(func(_ context.Context, scope string) (map[string]int, error) {
+				got.fetches = append(got.fetches, scope)
+				return map[string]int{"one": 1, "two": 2}, tc.err
+			}, WithResolverClock(func() time.Time { return now }))
+			for _, l := range tc.lookups {
+				now = now.Add(l.after)
+				id, ok, err := r.Resolve(context.Background(), l.scope, l.name)
+				got.results = append(got.results, result{id: id, ok: ok})
+				if err != nil {
+					got.err = err
+				}
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}, result{}), cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nResolve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestResolverCallerCancelled(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	r := NewResolver(func(_ context.Context, _ string) (map[string]int, error) {
+		close(started)
+		<-release
+		return map[string]int{"one": 1}, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+	go func() {
+		_, _, err := r.Resolve(ctx, "a", "one")
+		first <- err
+	}()
+	<-started
+	second := make(chan int)
+	go func() {
+		id, _, _ := r.Resolve(context.Background(), "a", "one")
+		second <- id
+	}()
+
+	cancel()
+	if diff := cmp.Diff(context.Canceled, <-first, cmpopts.EquateErrors()); diff != "" {
+		t.Errorf("\nResolve(...): a cancelled caller should stop waiting: -want, +got:\n%s", diff)
+	}
+	close(release)
+	if diff := cmp.Diff(1, <-second); diff != "" {
+		t.Errorf("\nResolve(...): other callers should not be failed by a cancelled caller: -want, +got:\n%s", diff)
+	}
+}
@@ -17,9 +17,11 @@ package configurations
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/common"
 )
 
 const (
@@ -39,30 +41,42 @@ func NewClient(cfg *up.Config) *Client {
 
 // List all configurations for an account on Upbound.
 func (c *Client) List(ctx context.Context, account string) (*ConfigurationListResponse, error) {
-	// Call listOnePage repeatedly to get all configurations.
-	configurations := &ConfigurationListResponse{}
-	page := 0
-	for {
-		configs, err := c.listOnePage(ctx, account, page)
+	configs, err := c.pager(account).Collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ConfigurationListResponse{
+		Configurations: configs,
+		Count:          len(configs),
+	}, nil
+}
+
+// ListAll returns an iterator over every configuration for an account on
+// Upbound, fetching pages as it advances.
+func (c *Client) ListAll(ctx context.Context, account string, opts ...common.ListOption) iter.Seq2[ConfigurationResponse, error] {
+	return c.pager(account, opts...).All(ctx)
+}
+
+// pager builds a pager over the configurations for an account.
+func (c *Client) pager(account string, opts ...common.ListOption) *common.Pager[ConfigurationResponse] {
+	return common.NewPager(func(ctx context.Context, opts ...common.ListOption) ([]ConfigurationResponse, int, error) {
+		res, err := c.listOnePage(ctx, account, opts...)
 		if err != nil {
-			return nil, err
-		}
-		configurations.Configurations = append(configurations.Configurations, configs.Configurations...)
-		configurations.Count += configs.Count
-		if configs.Count == 0 || configs.Count < configs.Size {
-			break
+			return nil, 0, err
 		}
-		page++
-	}
-	return configurations, nil
+		return res.Configurations, res.Size, nil
+	}, opts...)
 }
 
 // listOnePage gets one page of configuration.
-func (c *Client) listOnePage(ctx context.Context, account string, page int) (*ConfigurationListResponse, error) {
-	req, err := c.Client.NewRequest(ctx, http.MethodGet, basePath, fmt.Sprintf("%s?page=%d", account, page), nil)
+func (c *Client) listOnePage(ctx context.Context, account string, opts ...common.ListOption) (*ConfigurationListResponse, error) {
+	req, err := c.Client.NewRequest(ctx, http.MethodGet, basePath, account, nil)
 	if err != nil {
 		return nil, err
 	}
+	for _, o := range opts {
+		o(req)
+	}
 	configurations := &ConfigurationListResponse{}
 	err = c.Client.Do(req, &configurations)
 	if err != nil {
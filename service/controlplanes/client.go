@@ -16,6 +16,7 @@ package controlplanes
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"path"
 
@@ -84,6 +85,18 @@ func (c *Client) List(ctx context.Context, account string, opts ...common.ListOp
 	return cp, nil
 }
 
+// ListAll returns an iterator over every control plane in the given account
+// on Upbound, fetching pages as it advances.
+func (c *Client) ListAll(ctx context.Context, account string, opts ...common.ListOption) iter.Seq2[ControlPlaneResponse, error] {
+	return common.NewPager(func(ctx context.Context, opts ...common.ListOption) ([]ControlPlaneResponse, int, error) {
+		res, err := c.List(ctx, account, opts...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.ControlPlanes, res.Size, nil
+	}, opts...).All(ctx)
+}
+
 // Delete a control plane on Upbound.
 func (c *Client) Delete(ctx context.Context, account, name string) error { // nolint:interfacer
 	req, err := c.Client.NewRequest(ctx, http.MethodDelete, basePath, path.Join(account, name), nil)
@@ -21,8 +21,10 @@ import (
 	"net/http"
 	"path"
 	"strconv"
+	"sync"
 
 	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/common"
 )
 
 const (
@@ -33,15 +35,52 @@ const (
 // Client is an organizations client.
 type Client struct {
 	*up.Config
+
+	orgIDs     *common.Resolver[uint]
+	orgIDsOnce sync.Once
 }
 
-// NewClient builds an organizations client from the passed config.
-func NewClient(cfg *up.Config) *Client {
-	return &Client{
-		cfg,
+// A ClientOption modifies an organizations client.
+type ClientOption func(*Client)
+
+// WithOrgIDResolver sets the Resolver used to resolve organization names to
+// IDs, so that clients built with the same Resolver share its cache. Use
+// NewOrgIDResolver to build one.
+func WithOrgIDResolver(r *common.Resolver[uint]) ClientOption {
+	return func(c *Client) {
+		c.orgIDs = r
 	}
 }
 
+// NewClient builds an organizations client from the passed config. It
+// resolves organization names with a Resolver of its own unless one is
+// supplied with WithOrgIDResolver.
+func NewClient(cfg *up.Config, opts ...ClientOption) *Client {
+	c := &Client{Config: cfg}
+	for _, o := range opts {
+		o(c)
+	}
+	return c
+}
+
+// resolver returns the Resolver of the client, building one on first use if
+// none was supplied, such as for a Client that was not built with NewClient.
+func (c *Client) resolver() *common.Resolver[uint] {
+	c.orgIDsOnce.Do(func() {
+		if c.orgIDs == nil {
+			c.orgIDs = NewOrgIDResolver(c.Config)
+		}
+	})
+	return c.orgIDs
+}
+
+// NewOrgIDResolver builds a Resolver that resolves organization names to IDs
+// by listing the organizations of the authenticated user. It can be shared by
+// clients with WithOrgIDResolver.
+func NewOrgIDResolver(cfg *up.Config, opts ...common.ResolverOption) *common.Resolver[uint] {
+	return common.NewResolver((&Client{Config: cfg}).listOrgIDs, opts...)
+}
+
 // Create an organization on Upbound.
 func (c *Client) Create(ctx context.Context, params *OrganizationCreateParameters) error {
 	req, err := c.Client.NewRequest(ctx, http.MethodPost, basePath, "", params)
@@ -67,18 +106,45 @@ func (c *Client) Get(ctx context.Context, id uint) (*Organization, error) {
 
 // GetOrgID returns the ID of an org given its name.
 // There is a requirement that orgs are uniquely named, so we know
-// there is at most one org with the given name.
+// there is at most one org with the given name. IDs are cached, so
+// repeated calls do not list every organization.
 func (c *Client) GetOrgID(ctx context.Context, name string) (uint, error) {
-	orgs, err := c.List(ctx)
+	id, ok, err := c.resolver().Resolve(ctx, "", name)
 	if err != nil {
 		return 0, err
 	}
+	if !ok {
+		return 0, errors.New(errOrgNotFound)
+	}
+	return id, nil
+}
+
+// listOrgIDs maps the name of every organization of the authenticated user to
+// its ID.
+func (c *Client) listOrgIDs(ctx context.Context, _ string) (map[string]uint, error) {
+	orgs, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]uint, len(orgs))
 	for _, o := range orgs {
-		if o.Name == name {
-			return o.ID, nil
-		}
+		ids[o.Name] = o.ID
 	}
-	return 0, errors.New(errOrgNotFound)
+	return ids, nil
+}
+
+// Update the display name and settings of an organization on Upbound.
+func (c *Client) Update(ctx context.Context, id uint, params *OrganizationUpdateParameters) (*Organization, error) {
+	req, err := c.Client.NewRequest(ctx, http.MethodPatch, basePath, strconv.FormatUint(uint64(id), 10), params)
+	if err != nil {
+		return nil, err
+	}
+	org := &Organization{}
+	err = c.Client.Do(req, org)
+	if err != nil {
+		return nil, err
+	}
+	return org, nil
 }
 
 // List all organizations for the authenticated user on Upbound.
@@ -97,7 +163,8 @@ func (c *Client) List(ctx context.Context) ([]Organization, error) {
 
 // ListRobots list all robots the user can access in the organization on
 // Upbound.
-// TODO(hasheddan): move this to robots client when API is updated.
+//
+// Deprecated: Use robots.Client.List instead.
 func (c *Client) ListRobots(ctx context.Context, id uint) ([]Robot, error) {
 	req, err := c.Client.NewRequest(ctx, http.MethodGet, basePath, path.Join(strconv.FormatUint(uint64(id), 10), "robots"), nil)
 	if err != nil {
@@ -113,7 +180,8 @@ func (c *Client) ListRobots(ctx context.Context, id uint) ([]Robot, error) {
 
 // ListTeams list all teams the user can access in the organization on
 // Upbound.
-// TODO(haarchri): move this to teams client when API is updated.
+//
+// Deprecated: Use teams.Client.List or teams.Client.ListAll instead.
 func (c *Client) ListTeams(ctx context.Context, id uint) ([]Team, error) {
 	req, err := c.Client.NewRequest(ctx, http.MethodGet, basePath, path.Join(strconv.FormatUint(uint64(id), 10), "teams"), nil)
 	if err != nil {
@@ -133,7 +201,11 @@ func (c *Client) Delete(ctx context.Context, id uint) error {
 	if err != nil {
 		return err
 	}
-	return c.Client.Do(req, nil)
+	if err := c.Client.Do(req, nil); err != nil {
+		return err
+	}
+	c.resolver().Reset()
+	return nil
 }
 
 // ListInvites list all invites for the organization on Upbound.
@@ -169,6 +241,26 @@ func (c *Client) CreateInvite(ctx context.Context, orgID uint, params *Organizat
 	return c.Client.Do(req, nil)
 }
 
+// AcceptInvite accepts an invite to the organization on Upbound on behalf of
+// the authenticated user.
+func (c *Client) AcceptInvite(ctx context.Context, orgID uint, inviteID uint) error {
+	req, err := c.Client.NewRequest(ctx, http.MethodPost, basePath, fmt.Sprintf("%d/invites/%d/accept", orgID, inviteID), nil)
+	if err != nil {
+		return err
+	}
+	return c.Client.Do(req, nil)
+}
+
+// ResendInvite sends the email for an invite to the organization on Upbound
+// again.
+func (c *Client) ResendInvite(ctx context.Context, orgID uint, inviteID uint) error {
+	req, err := c.Client.NewRequest(ctx, http.MethodPost, basePath, fmt.Sprintf("%d/invites/%d/resend", orgID, inviteID), nil)
+	if err != nil {
+		return err
+	}
+	return c.Client.Do(req, nil)
+}
+
 // ListMembers list all members for the organization on Upbound.
 func (c *Client) ListMembers(ctx context.Context, orgID uint) ([]Member, error) {
 	req, err := c.Client.NewRequest(ctx, http.MethodGet, basePath, fmt.Sprintf("%d/members", orgID), nil)
@@ -191,3 +283,13 @@ func (c *Client) RemoveMember(ctx context.Context, orgID uint, userID uint) erro
 	}
 	return c.Client.Do(req, nil)
 }
+
+// UpdateMember changes the permission of a member of the organization on
+// Upbound.
+func (c *Client) UpdateMember(ctx context.Context, orgID uint, userID uint, permission OrganizationPermissionGroup) error {
+	req, err := c.Client.NewRequest(ctx, http.MethodPut, basePath, fmt.Sprintf("%d/members/%d", orgID, userID), &MemberUpdateParameters{Permission: permission})
+	if err != nil {
+		return err
+	}
+	return c.Client.Do(req, nil)
+}
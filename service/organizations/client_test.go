@@ -715,3 +715,127 @@ func TestListInvites(t *testing.T) {
 		})
 	}
 }
+
+func TestGetOrgID(t *testing.T) {
+	lists := 0
+	c := NewClient(&up.Config{
+		Client: &fake.MockClient{
+			MockNewRequest: fake.NewMockNewRequestFn(nil, nil),
+			MockDo: func(_ *http.Request, obj interface{}) error {
+				lists++
+				*obj.(*[]Organization) = []Organization{{ID: 1, Name: "acme"}, {ID: 2, Name: "other"}}
+				return nil
+			},
+		},
+	})
+	for _, name := range []string{"acme", "other"} {
+		if _, err := c.GetOrgID(context.Background(), name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if diff := cmp.Diff(1, lists); diff != "" {
+		t.Errorf("\nGetOrgID(...): organization IDs should be cached: -want lists, +got lists:\n%s", diff)
+	}
+	if _, err := c.GetOrgID(context.Background(), "missing"); err == nil || err.Error() != errOrgNotFound {
+		t.Errorf("\nGetOrgID(...): want error %q, got %v", errOrgNotFound, err)
+	}
+}
+
+func TestClientWithoutResolver(t *testing.T) {
+	lists := 0
+	c := &Client{Config: &up.Config{
+		Client: &fake.MockClient{
+			MockNewRequest: fake.NewMockNewRequestFn(nil, nil),
+			MockDo: func(_ *http.Request, obj interface{}) error {
+				if orgs, ok := obj.(*[]Organization); ok {
+					lists++
+					*orgs = []Organization{{ID: 1, Name: "acme"}}
+				}
+				return nil
+			},
+		},
+	}}
+	id, err := c.GetOrgID(context.Background(), "acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(uint(1), id); diff != "" {
+		t.Errorf("\nGetOrgID(...): -want, +got:\n%s", diff)
+	}
+	if err := c.Delete(context.Background(), id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetOrgID(context.Background(), "acme"); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(2, lists); diff != "" {
+		t.Errorf("\nGetOrgID(...): a Client built without NewClient should cache IDs until Delete: -want lists, +got lists:\n%s", diff)
+	}
+}
+
+func TestEndpoints(t *testing.T) {
+	name := "Acme"
+	type want struct {
+		method string
+		path   string
+		body   interface{}
+	}
+	cases := map[string]struct {
+		reason string
+		call   func(c *Client) error
+		want   want
+	}{
+		"Update": {
+			reason: "Updating an organization should patch its settings.",
+			call: func(c *Client) error {
+				_, err := c.Update(context.Background(), 1, &OrganizationUpdateParameters{DisplayName: &name})
+				return err
+			},
+			want: want{method: http.MethodPatch, path: "1", body: &OrganizationUpdateParameters{DisplayName: &name}},
+		},
+		"UpdateMember": {
+			reason: "Updating a member should put its new permission.",
+			call: func(c *Client) error {
+				return c.UpdateMember(context.Background(), 1, 2, OrganizationOwner)
+			},
+			want: want{method: http.MethodPut, path: "1/members/2", body: &MemberUpdateParameters{Permission: OrganizationOwner}},
+		},
+		"AcceptInvite": {
+			reason: "Accepting an invite should post to the invite.",
+			call: func(c *Client) error {
+				return c.AcceptInvite(context.Background(), 1, 3)
+			},
+			want: want{method: http.MethodPost, path: "1/invites/3/accept"},
+		},
+		"ResendInvite": {
+			reason: "Resending an invite should post to the invite.",
+			call: func(c *Client) error {
+				return c.ResendInvite(context.Background(), 1, 3)
+			},
+			want: want{method: http.MethodPost, path: "1/invites/3/resend"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got want
+			c := NewClient(&up.Config{
+				Client: &fake.MockClient{
+					MockNewRequest: func(_ context.Context, method, prefix, urlPath string, body interface{}) (*http.Request, error) {
+						if prefix != basePath {
+							t.Errorf("unexpected prefix: %s", prefix)
+						}
+						got = want{method: method, path: urlPath, body: body}
+						return nil, nil
+					},
+					MockDo: fake.NewMockDoFn(nil),
+				},
+			})
+			if err := tc.call(c); err != nil {
+				t.Errorf("\n%s\nunexpected error:\n%v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\n-want request, +got request:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
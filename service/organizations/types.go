@@ -64,6 +64,13 @@ type OrganizationCreateParameters struct {
 	DisplayName string `json:"displayName"`
 }
 
+// OrganizationUpdateParameters are the parameters for updating an
+// organization. Fields that are not set are left unchanged.
+type OrganizationUpdateParameters struct {
+	DisplayName          *string `json:"displayName,omitempty"`
+	ReservedEnvironments *int    `json:"reservedEnvironments,omitempty"`
+}
+
 // User is a user on Upbound.
 type User struct {
 	ID       uint   `json:"id"`
@@ -91,3 +98,9 @@ type OrganizationInviteCreateParameters struct {
 	Email      string                      `json:"email"`
 	Permission OrganizationPermissionGroup `json:"organizationPermission"`
 }
+
+// MemberUpdateParameters are the parameters for changing the permission of an
+// organization member.
+type MemberUpdateParameters struct {
+	Permission OrganizationPermissionGroup `json:"permission"`
+}
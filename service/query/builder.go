@@ -0,0 +1,457 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+const (
+	errNoSelection        = "query selects nothing to return; select at least one of ID, MutablePath, ControlPlane, Object, Table, a relation or Count"
+	errCursorWithOrder    = "a page cursor cannot be combined with an order because cursors are not stable under different orderings"
+	errNegativeLimit      = "limit must not be negative"
+	errNegativeFirst      = "first must not be negative"
+	errEmptyPath          = "object paths must not be empty"
+	errEmptyRelation      = "relation name must not be empty"
+	errNilRelation        = "related query must not be nil"
+	errRelationCycle      = "related query must not contain the query it is related to"
+	errEmptyCondition     = "condition type must not be empty"
+	errNoDirection        = "order direction must be Asc or Desc"
+	errUnknownField       = "unknown order field"
+	errTopLevelOnly       = "control plane filters and freshness can only be set on the top level query"
+	errFmtInvalidRelation = "invalid relation %q"
+)
+
+// Directions in which query results can be ordered.
+const (
+	Asc  = queryv1alpha2.Ascending
+	Desc = queryv1alpha2.Descending
+)
+
+// An OrderField is a field query results can be ordered by.
+type OrderField string
+
+// Fields query results can be ordered by.
+const (
+	Name              OrderField = "name"
+	Namespace         OrderField = "namespace"
+	CreationTimestamp OrderField = "creationTimestamp"
+	APIGroup          OrderField = "apiGroup"
+	Kind              OrderField = "kind"
+	Group             OrderField = "group"
+	ControlPlane      OrderField = "controlPlane"
+)
+
+// A Filter narrows the objects matched by a query.
+type Filter func(*queryv1alpha2.QueryFilter)
+
+// Condition matches objects with a condition of the supplied type and status,
+// e.g. Condition("Ready", "False"). Any status matches if it is empty.
+func Condition(conditionType, status string) Filter {
+	return ConditionReason(conditionType, status, "")
+}
+
+// ConditionReason matches objects with a condition of the supplied type,
+// status and reason. Any status or reason matches if it is empty.
+func ConditionReason(conditionType, status, reason string) Filter {
+	return func(f *queryv1alpha2.QueryFilter) {
+		f.Conditions = append(f.Conditions, queryv1alpha2.QueryCondition{Type: conditionType, Status: status, Reason: reason})
+	}
+}
+
+// Label matches objects with the supplied label.
+func Label(key, value string) Filter {
+	return func(f *queryv1alpha2.QueryFilter) {
+		if f.Labels == nil {
+			f.Labels = map[string]string{}
+		}
+		f.Labels[key] = value
+	}
+}
+
+// InNamespace matches objects in the supplied namespace within a control
+// plane.
+func InNamespace(namespace string) Filter {
+	return func(f *queryv1alpha2.QueryFilter) {
+		f.Namespace = namespace
+	}
+}
+
+// Named matches objects with the supplied name.
+func Named(name string) Filter {
+	return func(f *queryv1alpha2.QueryFilter) {
+		f.Name = name
+	}
+}
+
+// HasID matches the object with the supplied ID, as returned by a previous
+// query.
+func HasID(id string) Filter {
+	return func(f *queryv1alpha2.QueryFilter) {
+		f.ID = id
+	}
+}
+
+// InCategory matches objects in any of the supplied categories, e.g. managed
+// or composite.
+func InCategory(categories ...string) Filter {
+	return func(f *queryv1alpha2.QueryFilter) {
+		f.Categories = append(f.Categories, categories...)
+	}
+}
+
+// CreatedAfter matches objects created after t.
+func CreatedAfter(t time.Time) Filter {
+	return func(f *queryv1alpha2.QueryFilter) {
+		f.CreationTimestamp.After = metav1.NewTime(t)
+	}
+}
+
+// CreatedBefore matches objects created before t.
+func CreatedBefore(t time.Time) Filter {
+	return func(f *queryv1alpha2.QueryFilter) {
+		f.CreationTimestamp.Before = metav1.NewTime(t)
+	}
+}
+
+// JSONPath matches objects for which the supplied JSONPath filter expression
+// returns true. It is evaluated after every other filter and should be used
+// as a last resort.
+func JSONPath(expr string) Filter {
+	return func(f *queryv1alpha2.QueryFilter) {
+		f.JSONPath = expr
+	}
+}
+
+// A Builder builds a QuerySpec. Filters added with Kind and Where narrow the
+// current set of criteria, and Or starts a new one; objects matching any set
+// are returned. The first invalid argument is reported by Build.
+type Builder struct {
+	resources queryv1alpha2.QueryResources
+	objects   queryv1alpha2.QueryObjects
+	filters   []queryv1alpha2.QueryFilter
+
+	controlPlane queryv1alpha2.QueryFilterControlPlane
+	freshness    []queryv1alpha2.Freshness
+	relations    map[string]*Builder
+
+	err error
+}
+
+// Objects starts building a query for objects.
+func Objects() *Builder {
+	return &Builder{}
+}
+
+// Kind matches objects of the supplied API group and kind. Kinds are case
+// insensitive and also match plural resource names. Any group or kind matches
+// if it is empty.
+func (b *Builder) Kind(apiGroup, kind string) *Builder {
+	f := b.filter()
+	f.GroupKind = queryv1alpha2.QueryGroupKind{APIGroup: apiGroup, Kind: kind}
+	return b
+}
+
+// Where narrows the current set of criteria with the supplied filters.
+func (b *Builder) Where(filters ...Filter) *Builder {
+	f := b.filter()
+	for _, fn := range filters {
+		fn(f)
+	}
+	return b
+}
+
+// Or starts a new set of criteria. Objects matching any set are returned.
+func (b *Builder) Or() *Builder {
+	b.filters = append(b.filters, queryv1alpha2.QueryFilter{})
+	return b
+}
+
+// InControlPlane queries only the named control plane in the group. Any
+// group or control plane matches if it is empty. It is only valid at the top
+// level of a query.
+func (b *Builder) InControlPlane(group, name string) *Builder {
+	b.controlPlane = queryv1alpha2.QueryFilterControlPlane{Group: group, Name: name}
+	return b
+}
+
+// Fresh waits for the control plane in the group to reach the supplied
+// resource version before running the query. It is only valid at the top
+// level of a query.
+func (b *Builder) Fresh(group, controlPlane, resourceVersion string) *Builder {
+	b.freshness = append(b.freshness, queryv1alpha2.Freshness{Group: group, ControlPlane: controlPlane, ResourceVersion: resourceVersion})
+	return b
+}
+
+// OrderBy orders the results by the supplied field. The first call sets the
+// primary order, subsequent calls break ties.
+func (b *Builder) OrderBy(field OrderField, dir queryv1alpha2.Direction) *Builder {
+	if dir != Asc && dir != Desc {
+		b.fail(errors.New(errNoDirection))
+		return b
+	}
+	o := queryv1alpha2.QueryOrder{}
+	switch field {
+	case Name:
+		o.Name = dir
+	case Namespace:
+		o.Namespace = dir
+	case CreationTimestamp:
+		o.CreationTimestamp = dir
+	case APIGroup:
+		o.APIGroup = dir
+	case Kind:
+		o.Kind = dir
+	case Group:
+		o.Group = dir
+	case ControlPlane:
+		o.ControlPlane = dir
+	default:
+		b.fail(errors.Errorf("%s: %q", errUnknownField, field))
+		return b
+	}
+	b.resources.Order = append(b.resources.Order, o)
+	return b
+}
+
+// Limit returns at most n objects. The server defaults to 100.
+func (b *Builder) Limit(n int) *Builder {
+	if n < 0 {
+		b.fail(errors.New(errNegativeLimit))
+	}
+	b.resources.Limit = n
+	return b
+}
+
+// Count returns the number of matching objects remaining after paging.
+// Computing the count is expensive.
+func (b *Builder) Count() *Builder {
+	b.resources.Count = true
+	return b
+}
+
+// After returns objects starting at the supplied cursor, as returned in the
+// response to a previous query with the same order.
+func (b *Builder) After(cursor string) *Builder {
+	b.resources.Page.Cursor = cursor
+	b.resources.Cursor = true
+	return b
+}
+
+// First skips the first n objects.
+func (b *Builder) First(n int) *Builder {
+	if n < 0 {
+		b.fail(errors.New(errNegativeFirst))
+	}
+	b.resources.Page.First = n
+	return b
+}
+
+// ID returns the opaque ID of each object.
+func (b *Builder) ID() *Builder {
+	b.objects.ID = true
+	return b
+}
+
+// MutablePath returns the path of each object in the Kubernetes API of its
+// control plane.
+func (b *Builder) MutablePath() *Builder {
+	b.objects.MutablePath = true
+	return b
+}
+
+// ControlPlane returns the name and namespace of the control plane of each
+// object.
+func (b *Builder) ControlPlane() *Builder {
+	b.objects.ControlPlane = true
+	return b
+}
+
+// Object returns each object. If paths are supplied only those fields are
+// returned, e.g. Object("metadata.name", "status.conditions").
+func (b *Builder) Object(paths ...string) *Builder {
+	if len(paths) == 0 {
+		b.objects.Object = &common.JSON{Object: true}
+		return b
+	}
+	skeleton := map[string]any{}
+	if b.objects.Object != nil {
+		if m, ok := b.objects.Object.Object.(map[string]any); ok {
+			skeleton = m
+		}
+	}
+	for _, p := range paths {
+		if err := addPath(skeleton, p); err != nil {
+			b.fail(err)
+			return b
+		}
+	}
+	b.objects.Object = &common.JSON{Object: skeleton}
+	return b
+}
+
+// Table returns the objects as tables, grouped as supplied.
+func (b *Builder) Table(grouping queryv1alpha2.QueryGrouping) *Builder {
+	b.objects.Table = &queryv1alpha2.QueryTable{Grouping: grouping}
+	return b
+}
+
+// With returns the objects related to each object through the named
+// relation, e.g. owners, descendants or events, as selected by the supplied
+// builder.
+func (b *Builder) With(relation string, related *Builder) *Builder {
+	if relation == "" {
+		b.fail(errors.New(errEmptyRelation))
+		return b
+	}
+	if related == nil {
+		b.fail(errors.Wrapf(errors.New(errNilRelation), errFmtInvalidRelation, relation))
+		return b
+	}
+	if b.relations == nil {
+		b.relations = map[string]*Builder{}
+	}
+	b.relations[relation] = related
+	return b
+}
+
+// Build validates the query and returns its QuerySpec.
+func (b *Builder) Build() (*queryv1alpha2.QuerySpec, error) {
+	r, err := b.build(map[*Builder]bool{})
+	if err != nil {
+		return nil, err
+	}
+	f := queryv1alpha2.QueryTopLevelFilter{ControlPlane: b.controlPlane}
+	for _, qf := range b.filters {
+		f.Objects = append(f.Objects, *qf.DeepCopy())
+	}
+	return &queryv1alpha2.QuerySpec{
+		QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{QueryResources: r, Filter: f},
+		Freshness:              append([]queryv1alpha2.Freshness(nil), b.freshness...),
+	}, nil
+}
+
+// build validates the query and returns the resources it selects. Ancestors
+// are the builders whose relations contain this one; a builder that is its own
+// ancestor would nest forever.
+func (b *Builder) build(ancestors map[*Builder]bool) (queryv1alpha2.QueryResources, error) {
+	if b.err != nil {
+		return queryv1alpha2.QueryResources{}, b.err
+	}
+	if ancestors[b] {
+		return queryv1alpha2.QueryResources{}, errors.New(errRelationCycle)
+	}
+	ancestors[b] = true
+	defer delete(ancestors, b)
+	if b.resources.Page.Cursor != "" && len(b.resources.Order) > 0 {
+		return queryv1alpha2.QueryResources{}, errors.New(errCursorWithOrder)
+	}
+	for _, f := range b.filters {
+		for _, c := range f.Conditions {
+			if c.Type == "" {
+				return queryv1alpha2.QueryResources{}, errors.New(errEmptyCondition)
+			}
+		}
+	}
+	r := *b.resources.DeepCopy()
+	o := *b.objects.DeepCopy()
+	for name, related := range b.relations {
+		rel, err := related.relation(ancestors)
+		if err != nil {
+			return queryv1alpha2.QueryResources{}, errors.Wrapf(err, errFmtInvalidRelation, name)
+		}
+		if o.Relations == nil {
+			o.Relations = map[string]queryv1alpha2.QueryRelation{}
+		}
+		o.Relations[name] = rel
+	}
+	if isEmpty(o) {
+		if !r.Count {
+			return queryv1alpha2.QueryResources{}, errors.New(errNoSelection)
+		}
+		return r, nil
+	}
+	r.Objects = &o
+	return r, nil
+}
+
+// relation validates the builder as a nested query and returns its relation.
+func (b *Builder) relation(ancestors map[*Builder]bool) (queryv1alpha2.QueryRelation, error) {
+	if b.controlPlane != (queryv1alpha2.QueryFilterControlPlane{}) || len(b.freshness) > 0 {
+		return queryv1alpha2.QueryRelation{}, errors.New(errTopLevelOnly)
+	}
+	r, err := b.build(ancestors)
+	if err != nil {
+		return queryv1alpha2.QueryRelation{}, err
+	}
+	rel := queryv1alpha2.QueryRelation{}
+	rel.QueryResources = r
+	for _, f := range b.filters {
+		rel.Filters = append(rel.Filters, *f.DeepCopy())
+	}
+	return rel, nil
+}
+
+// filter returns the current set of criteria, starting one if necessary.
+func (b *Builder) filter() *queryv1alpha2.QueryFilter {
+	if len(b.filters) == 0 {
+		b.filters = append(b.filters, queryv1alpha2.QueryFilter{})
+	}
+	return &b.filters[len(b.filters)-1]
+}
+
+func (b *Builder) fail(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// isEmpty returns true if the objects select nothing to return.
+func isEmpty(o queryv1alpha2.QueryObjects) bool {
+	return !o.ID && !o.MutablePath && !o.ControlPlane && o.Object == nil && o.Table == nil && len(o.Relations) == 0
+}
+
+// addPath adds the dot separated path to the object skeleton. A path that is
+// a prefix of another selects the whole subtree.
+func addPath(skeleton map[string]any, p string) error {
+	parts := strings.Split(p, ".")
+	for i, part := range parts {
+		if part == "" {
+			return errors.New(errEmptyPath)
+		}
+		if i == len(parts)-1 {
+			skeleton[part] = true
+			return nil
+		}
+		next, ok := skeleton[part].(map[string]any)
+		if !ok {
+			if skeleton[part] == true {
+				// The whole subtree is already selected.
+				return nil
+			}
+			next = map[string]any{}
+			skeleton[part] = next
+		}
+		skeleton = next
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+func TestBuild(t *testing.T) {
+	type want struct {
+		spec *queryv1alpha2.QuerySpec
+		err  error
+	}
+	cases := map[string]struct {
+		reason string
+		b      *Builder
+		want   want
+	}{
+		"Full": {
+			reason: "Filters, order, paging and selections should be set on the spec.",
+			b: Objects().
+				Kind("apiextensions.crossplane.io", "Composition").
+				Where(Condition("Ready", "False"), Label("a", "b")).
+				Or().
+				Where(Named("xr"), JSONPath("$.spec.paused == true")).
+				InControlPlane("default", "ctp").
+				Fresh("default", "ctp", "42").
+				OrderBy(Name, Desc).
+				OrderBy(CreationTimestamp, Asc).
+				Limit(10).
+				Count().
+				ID().
+				Object("metadata.name", "status.conditions", "metadata"),
+			want: want{spec: &queryv1alpha2.QuerySpec{
+				QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+					QueryResources: queryv1alpha2.QueryResources{
+						Count: true,
+						Objects: &queryv1alpha2.QueryObjects{
+							ID: true,
+							Object: &common.JSON{Object: map[string]any{
+								"metadata": true,
+								"status":   map[string]any{"conditions": true},
+							}},
+						},
+						Order: []queryv1alpha2.QueryOrder{{Name: Desc}, {CreationTimestamp: Asc}},
+						Limit: 10,
+					},
+					Filter: queryv1alpha2.QueryTopLevelFilter{
+						ControlPlane: queryv1alpha2.QueryFilterControlPlane{Group: "default", Name: "ctp"},
+						Objects: []queryv1alpha2.QueryFilter{
+							{
+								GroupKind:  queryv1alpha2.QueryGroupKind{APIGroup: "apiextensions.crossplane.io", Kind: "Composition"},
+								Conditions: []queryv1alpha2.QueryCondition{{Type: "Ready", Status: "False"}},
+								Labels:     map[string]string{"a": "b"},
+							},
+							{Name: "xr", JSONPath: "$.spec.paused == true"},
+						},
+					},
+				},
+				Freshness: []queryv1alpha2.Freshness{{Group: "default", ControlPlane: "ctp", ResourceVersion: "42"}},
+			}},
+		},
+		"Relation": {
+			reason: "A related query should be nested in the relations of the objects.",
+			b: Objects().
+				Kind("", "XNetwork").
+				ID().
+				With("owners", Objects().Where(InNamespace("default")).Object()),
+			want: want{spec: &queryv1alpha2.QuerySpec{
+				QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+					QueryResources: queryv1alpha2.QueryResources{
+						Objects: &queryv1alpha2.QueryObjects{
+							ID: true,
+							Relations: map[string]queryv1alpha2.QueryRelation{
+								"owners": {QueryNestedResources: queryv1alpha2.QueryNestedResources{
+									QueryResources: queryv1alpha2.QueryResources{
+										Objects: &queryv1alpha2.QueryObjects{Object: &common.JSON{Object: true}},
+									},
+									Filters: []queryv1alpha2.QueryFilter{{Namespace: "default"}},
+								}},
+							},
+						},
+					},
+					Filter: queryv1alpha2.QueryTopLevelFilter{
+						Objects: []queryv1alpha2.QueryFilter{{GroupKind: queryv1alpha2.QueryGroupKind{Kind: "XNetwork"}}},
+					},
+				},
+			}},
+		},
+		"CountOnly": {
+			reason: "A query may only count objects.",
+			b:      Objects().Count(),
+			want: want{spec: &queryv1alpha2.QuerySpec{
+				QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+					QueryResources: queryv1alpha2.QueryResources{Count: true},
+				},
+			}},
+		},
+		"NoSelection": {
+			reason: "A query that selects nothing to return should be rejected.",
+			b:      Objects().Kind("", "Composition"),
+			want:   want{err: errors.New(errNoSelection)},
+		},
+		"CursorWithOrder": {
+			reason: "A cursor combined with an order should be rejected.",
+			b:      Objects().ID().OrderBy(Name, Asc).After("c1"),
+			want:   want{err: errors.New(errCursorWithOrder)},
+		},
+		"EmptyCondition": {
+			reason: "A condition without a type should be rejected.",
+			b:      Objects().ID().Where(Condition("", "True")),
+			want:   want{err: errors.New(errEmptyCondition)},
+		},
+		"BadDirection": {
+			reason: "An order direction other than Asc or Desc should be rejected.",
+			b:      Objects().ID().OrderBy(Name, "Up"),
+			want:   want{err: errors.New(errNoDirection)},
+		},
+		"NegativeLimit": {
+			reason: "A negative limit should be rejected.",
+			b:      Objects().ID().Limit(-1),
+			want:   want{err: errors.New(errNegativeLimit)},
+		},
+		"EmptyPath": {
+			reason: "An object path with an empty segment should be rejected.",
+			b:      Objects().Object("metadata..name"),
+			want:   want{err: errors.New(errEmptyPath)},
+		},
+		"TopLevelOnlyInRelation": {
+			reason: "A related query should not filter by control plane.",
+			b:      Objects().ID().With("owners", Objects().ID().InControlPlane("default", "")),
+			want:   want{err: errors.Wrapf(errors.New(errTopLevelOnly), errFmtInvalidRelation, "owners")},
+		},
+		"NilRelation": {
+			reason: "A relation without a related query should be rejected.",
+			b:      Objects().ID().With("owners", nil),
+			want:   want{err: errors.Wrapf(errors.New(errNilRelation), errFmtInvalidRelation, "owners")},
+		},
+		"InvalidRelation": {
+			reason: "A related query should be validated.",
+			b:      Objects().ID().With("owners", Objects()),
+			want:   want{err: errors.Wrapf(errors.New(errNoSelection), errFmtInvalidRelation, "owners")},
+		},
+		"RelationCycle": {
+			reason: "A related query that contains the query it is related to should be rejected rather than nest forever.",
+			b: func() *Builder {
+				b := Objects().ID()
+				return b.With("owners", Objects().ID().With("events", b))
+			}(),
+			want: want{err: errors.Wrapf(errors.Wrapf(errors.New(errRelationCycle), errFmtInvalidRelation, "events"), errFmtInvalidRelation, "owners")},
+		},
+		"SharedRelation": {
+			reason: "A related query used by more than one relation is not a cycle.",
+			b: func() *Builder {
+				related := Objects().ID()
+				return Objects().ID().With("owners", related).With("events", related)
+			}(),
+			want: want{spec: &queryv1alpha2.QuerySpec{
+				QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+					QueryResources: queryv1alpha2.QueryResources{
+						Objects: &queryv1alpha2.QueryObjects{
+							ID: true,
+							Relations: map[string]queryv1alpha2.QueryRelation{
+								"owners": {QueryNestedResources: queryv1alpha2.QueryNestedResources{QueryResources: queryv1alpha2.QueryResources{Objects: &queryv1alpha2.QueryObjects{ID: true}}}},
+								"events": {QueryNestedResources: queryv1alpha2.QueryNestedResources{QueryResources: queryv1alpha2.QueryResources{Objects: &queryv1alpha2.QueryObjects{ID: true}}}},
+							},
+						},
+					},
+				},
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.b.Build()
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nBuild(): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.spec, got); diff != "" {
+				t.Errorf("\n%s\nBuild(): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
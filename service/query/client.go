@@ -0,0 +1,189 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"context"
+	"iter"
+	"slices"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/upbound/up-sdk-go"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+	"github.com/upbound/up-sdk-go/service/spaces"
+)
+
+const (
+	spaceQueriesPath = "spacequeries"
+	groupQueriesPath = "groupqueries"
+	queriesPath      = "queries"
+
+	errNoGroup        = "a group is required to query a control plane"
+	errCursorRepeated = "query returned the cursor it was sent"
+)
+
+// Client executes queries against the Spaces API served by Upbound.
+type Client struct {
+	// Queries cannot be listed, so each object type doubles as its list type.
+	space *spaces.ResourceClient[*queryv1alpha2.SpaceQuery, *queryv1alpha2.SpaceQuery]
+	group *spaces.ResourceClient[*queryv1alpha2.GroupQuery, *queryv1alpha2.GroupQuery]
+	query *spaces.ResourceClient[*queryv1alpha2.Query, *queryv1alpha2.Query]
+}
+
+// NewClient builds a query client from the passed config.
+func NewClient(cfg *up.Config) *Client {
+	newSpaceQuery := func() *queryv1alpha2.SpaceQuery { return &queryv1alpha2.SpaceQuery{} }
+	newGroupQuery := func() *queryv1alpha2.GroupQuery { return &queryv1alpha2.GroupQuery{} }
+	newQuery := func() *queryv1alpha2.Query { return &queryv1alpha2.Query{} }
+	return &Client{
+		space: spaces.NewResourceClient(cfg, queryv1alpha2.SchemeGroupVersion.WithResource(spaceQueriesPath), newSpaceQuery, newSpaceQuery),
+		group: spaces.NewResourceClient(cfg, queryv1alpha2.SchemeGroupVersion.WithResource(groupQueriesPath), newGroupQuery, newGroupQuery),
+		query: spaces.NewResourceClient(cfg, queryv1alpha2.SchemeGroupVersion.WithResource(queriesPath), newQuery, newQuery),
+	}
+}
+
+// SpaceQuery runs the query against every control plane in the Space.
+func (c *Client) SpaceQuery(ctx context.Context, spec *queryv1alpha2.QuerySpec) (*Result, error) {
+	res, err := c.space.Create(ctx, "", &queryv1alpha2.SpaceQuery{Spec: spec}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return newResult(res.Response), nil
+}
+
+// GroupQuery runs the query against every control plane in the group.
+func (c *Client) GroupQuery(ctx context.Context, group string, spec *queryv1alpha2.QuerySpec) (*Result, error) {
+	res, err := c.group.Create(ctx, group, &queryv1alpha2.GroupQuery{Spec: spec}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return newResult(res.Response), nil
+}
+
+// Query runs the query against the named control plane in the group.
+func (c *Client) Query(ctx context.Context, group, controlPlane string, spec *queryv1alpha2.QuerySpec) (*Result, error) {
+	q := &queryv1alpha2.Query{
+		ObjectMeta: metav1.ObjectMeta{Namespace: group, Name: controlPlane},
+		Spec:       spec,
+	}
+	res, err := c.query.Create(ctx, group, q, nil)
+	if err != nil {
+		return nil, err
+	}
+	return newResult(res.Response), nil
+}
+
+// Do runs the query against the control planes selected by the scope.
+func (c *Client) Do(ctx context.Context, scope Scope, spec *queryv1alpha2.QuerySpec) (*Result, error) {
+	switch {
+	case scope.ControlPlane != "" && scope.Group == "":
+		return nil, errors.New(errNoGroup)
+	case scope.ControlPlane != "":
+		return c.Query(ctx, scope.Group, scope.ControlPlane, spec)
+	case scope.Group != "":
+		return c.GroupQuery(ctx, scope.Group, spec)
+	default:
+		return c.SpaceQuery(ctx, spec)
+	}
+}
+
+// Pages returns an iterator over every page of results of the query,
+// starting from the cursor in the spec, if any. The query is sent again with
+// the cursor of each page until a page has no next cursor. Iteration yields
+// an error and stops if a page cannot be fetched or ctx is done. The supplied
+// spec is not modified.
+func (c *Client) Pages(ctx context.Context, scope Scope, spec *queryv1alpha2.QuerySpec) iter.Seq2[*Result, error] {
+	return func(yield func(*Result, error) bool) {
+		s := &queryv1alpha2.QuerySpec{}
+		if spec != nil {
+			s = spec.DeepCopy()
+		}
+		s.Cursor = true
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+			res, err := c.Do(ctx, scope, s)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(res, nil) {
+				return
+			}
+			next := res.Next()
+			if next == "" || len(res.Objects)+len(res.Tables) == 0 {
+				return
+			}
+			// Guard against a server that never advances the cursor.
+			if next == s.Page.Cursor {
+				yield(nil, errors.New(errCursorRepeated))
+				return
+			}
+			s.Page = queryv1alpha2.QueryPage{Cursor: next}
+		}
+	}
+}
+
+// All returns an iterator over the objects on every page of results of the
+// query. See Pages.
+func (c *Client) All(ctx context.Context, scope Scope, spec *queryv1alpha2.QuerySpec) iter.Seq2[queryv1alpha2.QueryResponseObject, error] {
+	return func(yield func(queryv1alpha2.QueryResponseObject, error) bool) {
+		for res, err := range c.Pages(ctx, scope, spec) {
+			if err != nil {
+				yield(queryv1alpha2.QueryResponseObject{}, err)
+				return
+			}
+			for _, o := range res.Objects {
+				if !yield(o, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Collect fetches every page of results of the query and merges them into
+// one Result. Warnings are deduplicated, and the cursor and count are those of
+// the last page.
+//
+// The server marks every page fetched with a cursor as incomplete, so the
+// merged Result is only incomplete if the query was limited before a cursor
+// could be followed.
+func (c *Client) Collect(ctx context.Context, scope Scope, spec *queryv1alpha2.QuerySpec) (*Result, error) {
+	all := &Result{}
+	fromStart := spec == nil || (spec.Page.Cursor == "" && spec.Page.First == 0)
+	pages := 0
+	for res, err := range c.Pages(ctx, scope, spec) {
+		if err != nil {
+			return nil, err
+		}
+		all.Objects = append(all.Objects, res.Objects...)
+		all.Tables = append(all.Tables, res.Tables...)
+		for _, w := range res.Warnings {
+			if !slices.Contains(all.Warnings, w) {
+				all.Warnings = append(all.Warnings, w)
+			}
+		}
+		pages++
+		all.Count = res.Count
+		all.Cursor = res.Cursor
+		all.Incomplete = res.Incomplete && pages == 1 && fromStart
+	}
+	return all, nil
+}
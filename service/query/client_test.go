@@ -0,0 +1,231 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/upbound/up-sdk-go"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+// sent is a query received by a test server.
+type sent struct {
+	path   string
+	kind   string
+	name   string
+	cursor string
+}
+
+// fakeServer serves pages of responses in order, recording every query it
+// receives.
+func fakeServer(t *testing.T, got *[]sent, pages ...queryv1alpha2.QueryResponse) *up.Config {
+	t.Helper()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := &queryv1alpha2.Query{}
+		if err := json.NewDecoder(r.Body).Decode(q); err != nil {
+			t.Fatal(err)
+		}
+		*got = append(*got, sent{path: r.URL.Path, kind: q.Kind, name: q.GetName(), cursor: q.Spec.Page.Cursor})
+		q.Response = &pages[len(*got)-1]
+		if err := json.NewEncoder(w).Encode(q); err != nil {
+			t.Fatal(err)
+		}
+	}))
+	t.Cleanup(s.Close)
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return up.NewConfig(func(cfg *up.Config) {
+		cfg.Client = up.NewClient(func(c *up.HTTPClient) {
+			c.BaseURL = u
+			c.HTTP = s.Client()
+		})
+	})
+}
+
+func object(id string) queryv1alpha2.QueryResponseObject {
+	return queryv1alpha2.QueryResponseObject{ID: id}
+}
+
+func TestDo(t *testing.T) {
+	type want struct {
+		sent []sent
+		res  *Result
+		err  error
+	}
+	cases := map[string]struct {
+		reason string
+		scope  Scope
+		want   want
+	}{
+		"Space": {
+			reason: "An empty scope should run a SpaceQuery.",
+			want: want{
+				sent: []sent{{path: "/apis/query.spaces.upbound.io/v1alpha2/spacequeries", kind: "SpaceQuery"}},
+				res:  &Result{Objects: []queryv1alpha2.QueryResponseObject{object("a")}, Warnings: []string{"slow"}, Incomplete: true},
+			},
+		},
+		"Group": {
+			reason: "A scope with only a group should run a GroupQuery in that group.",
+			scope:  Scope{Group: "default"},
+			want: want{
+				sent: []sent{{path: "/apis/query.spaces.upbound.io/v1alpha2/namespaces/default/groupqueries", kind: "GroupQuery"}},
+				res:  &Result{Objects: []queryv1alpha2.QueryResponseObject{object("a")}, Warnings: []string{"slow"}, Incomplete: true},
+			},
+		},
+		"ControlPlane": {
+			reason: "A scope with a control plane should run a Query named after it.",
+			scope:  Scope{Group: "default", ControlPlane: "ctp"},
+			want: want{
+				sent: []sent{{path: "/apis/query.spaces.upbound.io/v1alpha2/namespaces/default/queries", kind: "Query", name: "ctp"}},
+				res:  &Result{Objects: []queryv1alpha2.QueryResponseObject{object("a")}, Warnings: []string{"slow"}, Incomplete: true},
+			},
+		},
+		"NoGroup": {
+			reason: "A control plane cannot be queried without its group.",
+			scope:  Scope{ControlPlane: "ctp"},
+			want:   want{err: errors.New(errNoGroup)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []sent
+			cfg := fakeServer(t, &got, queryv1alpha2.QueryResponse{
+				Warnings:             []string{"slow"},
+				QueryResponseObjects: queryv1alpha2.QueryResponseObjects{Objects: []queryv1alpha2.QueryResponseObject{object("a")}, Incomplete: true},
+			})
+			res, err := NewClient(cfg).Do(context.Background(), tc.scope, &queryv1alpha2.QuerySpec{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDo(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.res, res); diff != "" {
+				t.Errorf("\n%s\nDo(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.sent, got, cmp.AllowUnexported(sent{})); diff != "" {
+				t.Errorf("\n%s\nDo(...): -want sent, +got sent:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCollect(t *testing.T) {
+	type want struct {
+		sent []sent
+		res  *Result
+		err  error
+	}
+	cases := map[string]struct {
+		reason string
+		pages  []queryv1alpha2.QueryResponse
+		want   want
+	}{
+		"FollowsCursor": {
+			reason: "The cursor of every page should be followed until there are no more pages.",
+			pages: []queryv1alpha2.QueryResponse{
+				{Warnings: []string{"slow"}, QueryResponseObjects: queryv1alpha2.QueryResponseObjects{
+					Objects: []queryv1alpha2.QueryResponseObject{object("a")}, Cursor: &queryv1alpha2.QueryResponseCursor{Next: "c1"}, Incomplete: true,
+				}},
+				{Warnings: []string{"slow"}, QueryResponseObjects: queryv1alpha2.QueryResponseObjects{
+					Objects: []queryv1alpha2.QueryResponseObject{object("b")}, Cursor: &queryv1alpha2.QueryResponseCursor{Next: "c2"}, Incomplete: true,
+				}},
+				{QueryResponseObjects: queryv1alpha2.QueryResponseObjects{
+					Objects: []queryv1alpha2.QueryResponseObject{object("c")}, Cursor: &queryv1alpha2.QueryResponseCursor{}, Incomplete: true,
+				}},
+			},
+			want: want{
+				sent: []sent{{cursor: ""}, {cursor: "c1"}, {cursor: "c2"}},
+				res: &Result{
+					Objects:  []queryv1alpha2.QueryResponseObject{object("a"), object("b"), object("c")},
+					Cursor:   &queryv1alpha2.QueryResponseCursor{},
+					Warnings: []string{"slow"},
+				},
+			},
+		},
+		"SinglePage": {
+			reason: "A single limited page without a cursor should be reported as incomplete.",
+			pages: []queryv1alpha2.QueryResponse{
+				{QueryResponseObjects: queryv1alpha2.QueryResponseObjects{Objects: []queryv1alpha2.QueryResponseObject{object("a")}, Incomplete: true}},
+			},
+			want: want{
+				sent: []sent{{cursor: ""}},
+				res:  &Result{Objects: []queryv1alpha2.QueryResponseObject{object("a")}, Incomplete: true},
+			},
+		},
+		"CursorRepeated": {
+			reason: "A server returning the cursor it was sent should not cause an endless loop.",
+			pages: []queryv1alpha2.QueryResponse{
+				{QueryResponseObjects: queryv1alpha2.QueryResponseObjects{Objects: []queryv1alpha2.QueryResponseObject{object("a")}, Cursor: &queryv1alpha2.QueryResponseCursor{Next: "c1"}}},
+				{QueryResponseObjects: queryv1alpha2.QueryResponseObjects{Objects: []queryv1alpha2.QueryResponseObject{object("a")}, Cursor: &queryv1alpha2.QueryResponseCursor{Next: "c1"}}},
+			},
+			want: want{
+				sent: []sent{{cursor: ""}, {cursor: "c1"}},
+				err:  errors.New(errCursorRepeated),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []sent
+			cfg := fakeServer(t, &got, tc.pages...)
+			res, err := NewClient(cfg).Collect(context.Background(), Scope{}, nil)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCollect(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.res, res); diff != "" {
+				t.Errorf("\n%s\nCollect(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			for i := range got {
+				got[i] = sent{cursor: got[i].cursor}
+			}
+			if diff := cmp.Diff(tc.want.sent, got, cmp.AllowUnexported(sent{})); diff != "" {
+				t.Errorf("\n%s\nCollect(...): -want sent, +got sent:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAllStopsEarly(t *testing.T) {
+	var got []sent
+	cfg := fakeServer(t, &got,
+		queryv1alpha2.QueryResponse{QueryResponseObjects: queryv1alpha2.QueryResponseObjects{
+			Objects: []queryv1alpha2.QueryResponseObject{object("a"), object("b")}, Cursor: &queryv1alpha2.QueryResponseCursor{Next: "c1"},
+		}},
+	)
+	var ids []string
+	for o, err := range NewClient(cfg).All(context.Background(), Scope{Group: "default"}, nil) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, o.ID)
+		break
+	}
+	if diff := cmp.Diff([]string{"a"}, ids); diff != "" {
+		t.Errorf("\nAll(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(1, len(got)); diff != "" {
+		t.Errorf("\nAll(...): no further pages should be fetched once iteration stops: -want, +got:\n%s", diff)
+	}
+}
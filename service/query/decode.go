@@ -0,0 +1,123 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"github.com/upbound/up-sdk-go/apis"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+const (
+	errNoObject     = "query result does not include the object"
+	errFmtNotTyped  = "cannot decode %s into a typed object"
+	errFmtConvert   = "cannot convert %s"
+	errFmtWrongType = "object is a %T, not a %T"
+)
+
+// NewScheme returns a scheme with every API group of the apis package, which
+// query results are decoded into by default.
+func NewScheme() *runtime.Scheme {
+	s := runtime.NewScheme()
+	utilruntime.Must(apis.AddToScheme(s))
+	return s
+}
+
+// A DecoderOption modifies a Decoder.
+type DecoderOption func(*Decoder)
+
+// WithScheme sets the scheme objects are decoded through, e.g. one that also
+// knows about Crossplane or provider types.
+func WithScheme(s *runtime.Scheme) DecoderOption {
+	return func(d *Decoder) {
+		d.scheme = s
+	}
+}
+
+// A Decoder decodes the objects returned by a query.
+type Decoder struct {
+	scheme *runtime.Scheme
+}
+
+// NewDecoder builds a Decoder that uses the scheme returned by NewScheme
+// unless another is supplied.
+func NewDecoder(opts ...DecoderOption) *Decoder {
+	d := &Decoder{scheme: NewScheme()}
+	for _, o := range opts {
+		o(d)
+	}
+	return d
+}
+
+// Decode decodes the object into the typed object registered for its kind in
+// the decoder's scheme, or into an *unstructured.Unstructured if its kind is
+// not registered. Only the fields selected by the query are set.
+func (d *Decoder) Decode(o queryv1alpha2.QueryResponseObject) (runtime.Object, error) {
+	u, err := Unstructured(o)
+	if err != nil {
+		return nil, err
+	}
+	gvk := u.GroupVersionKind()
+	if gvk.Empty() || !d.scheme.Recognizes(gvk) {
+		return u, nil
+	}
+	obj, err := d.scheme.New(gvk)
+	if err != nil {
+		return nil, errors.Wrapf(err, errFmtNotTyped, gvk)
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj); err != nil {
+		return nil, errors.Wrapf(err, errFmtConvert, gvk)
+	}
+	return obj, nil
+}
+
+// DecodeInto decodes the object into the supplied typed object, regardless of
+// whether its kind is registered in a scheme.
+func DecodeInto(o queryv1alpha2.QueryResponseObject, into runtime.Object) error {
+	u, err := Unstructured(o)
+	if err != nil {
+		return err
+	}
+	return errors.Wrapf(runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, into), errFmtConvert, u.GroupVersionKind())
+}
+
+// DecodeAs decodes the object with the decoder and returns it as T, e.g.
+// *v1beta1.ControlPlane. It returns an error if the object decodes to another
+// type.
+func DecodeAs[T runtime.Object](d *Decoder, o queryv1alpha2.QueryResponseObject) (T, error) {
+	var zero T
+	obj, err := d.Decode(o)
+	if err != nil {
+		return zero, err
+	}
+	t, ok := obj.(T)
+	if !ok {
+		return zero, errors.Errorf(errFmtWrongType, obj, zero)
+	}
+	return t, nil
+}
+
+// Unstructured returns the object as an *unstructured.Unstructured. The
+// returned object shares no state with the query result.
+func Unstructured(o queryv1alpha2.QueryResponseObject) (*unstructured.Unstructured, error) {
+	if o.Object == nil || o.Object.Object == nil {
+		return nil, errors.New(errNoObject)
+	}
+	return &unstructured.Unstructured{Object: runtime.DeepCopyJSON(o.Object.Object)}, nil
+}
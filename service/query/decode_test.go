@@ -0,0 +1,105 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+)
+
+func withObject(obj map[string]any) queryv1alpha2.QueryResponseObject {
+	return queryv1alpha2.QueryResponseObject{Object: &common.JSONObject{Object: obj}}
+}
+
+func TestDecode(t *testing.T) {
+	type want struct {
+		obj runtime.Object
+		err error
+	}
+	cases := map[string]struct {
+		reason string
+		o      queryv1alpha2.QueryResponseObject
+		want   want
+	}{
+		"Typed": {
+			reason: "An object of a kind registered in the scheme should be decoded into its type.",
+			o: withObject(map[string]any{
+				"apiVersion": "spaces.upbound.io/v1beta1",
+				"kind":       "ControlPlane",
+				"metadata":   map[string]any{"name": "ctp", "namespace": "default"},
+			}),
+			want: want{obj: &spacesv1beta1.ControlPlane{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "spaces.upbound.io/v1beta1", Kind: "ControlPlane"},
+				ObjectMeta: metav1.ObjectMeta{Name: "ctp", Namespace: "default"},
+			}},
+		},
+		"Unstructured": {
+			reason: "An object of an unknown kind should be decoded as unstructured.",
+			o: withObject(map[string]any{
+				"apiVersion": "apiextensions.crossplane.io/v1",
+				"kind":       "Composition",
+				"metadata":   map[string]any{"name": "xnetworks"},
+			}),
+			want: want{obj: &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "apiextensions.crossplane.io/v1",
+				"kind":       "Composition",
+				"metadata":   map[string]any{"name": "xnetworks"},
+			}}},
+		},
+		"NoObject": {
+			reason: "A result that does not include the object should return an error.",
+			o:      queryv1alpha2.QueryResponseObject{ID: "a"},
+			want:   want{err: errors.New(errNoObject)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewDecoder().Decode(tc.o)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDecode(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obj, got); diff != "" {
+				t.Errorf("\n%s\nDecode(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDecodeAs(t *testing.T) {
+	o := withObject(map[string]any{
+		"apiVersion": "apiextensions.crossplane.io/v1",
+		"kind":       "Composition",
+	})
+	if _, err := DecodeAs[*spacesv1beta1.ControlPlane](NewDecoder(), o); err == nil {
+		t.Errorf("\nDecodeAs(...): an object of another type should return an error")
+	}
+	cp := &spacesv1beta1.ControlPlane{}
+	if err := DecodeInto(withObject(map[string]any{"metadata": map[string]any{"name": "ctp"}}), cp); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("ctp", cp.GetName()); diff != "" {
+		t.Errorf("\nDecodeInto(...): a sparse object should be decoded: -want, +got:\n%s", diff)
+	}
+}
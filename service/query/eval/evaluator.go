@@ -0,0 +1,419 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package eval evaluates queries against objects held in memory, without a
+// Space. It is meant for unit tests and for tooling that works on exported
+// control plane state. Responses have the shape the query API returns, but
+// IDs, cursors and table columns are its own.
+package eval
+
+import (
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+	"github.com/upbound/up-sdk-go/service/query"
+)
+
+const (
+	// DefaultLimit is the number of objects returned if a query does not set
+	// a limit.
+	DefaultLimit = 100
+
+	errNoSpec           = "query has no spec"
+	errNoGroup          = "a control plane scope requires a group"
+	errNegativeLimit    = "limit must not be negative"
+	errNegativeFirst    = "first must not be negative"
+	errInvalidSkeleton  = "object skeleton values must be true or an object"
+	errFmtUnknownCursor = "unknown cursor %q"
+	errFmtOrderFields   = "order %d must set exactly one field"
+	errFmtDirection     = "order %d has invalid direction %q"
+	errFmtRelation      = "cannot evaluate relation %q"
+)
+
+// An Option configures an Evaluator.
+type Option func(*Evaluator)
+
+// WithCategories adds the kind to the supplied categories, e.g. managed or
+// composite. Objects carry no category information, so filters by category
+// only match kinds added to it.
+func WithCategories(gk schema.GroupKind, categories ...string) Option {
+	return func(e *Evaluator) {
+		e.categories[gk] = append(e.categories[gk], categories...)
+	}
+}
+
+// An Evaluator evaluates queries against objects held in memory.
+type Evaluator struct {
+	objects    []*object
+	categories map[schema.GroupKind][]string
+}
+
+// New returns an Evaluator without objects.
+func New(opts ...Option) *Evaluator {
+	e := &Evaluator{categories: map[schema.GroupKind][]string{}}
+	for _, o := range opts {
+		o(e)
+	}
+	return e
+}
+
+// object is an object in a control plane.
+type object struct {
+	*unstructured.Unstructured
+
+	id           string
+	group        string
+	controlPlane string
+}
+
+func (o *object) sameControlPlane(other *object) bool {
+	return o.group == other.group && o.controlPlane == other.controlPlane
+}
+
+// Add adds copies of the objects in the supplied control plane.
+func (e *Evaluator) Add(group, controlPlane string, objs ...*unstructured.Unstructured) {
+	for _, u := range objs {
+		gvk := u.GroupVersionKind()
+		e.objects = append(e.objects, &object{
+			Unstructured: u.DeepCopy(),
+			id:           strings.Join([]string{group, controlPlane, gvk.Group, gvk.Kind, u.GetNamespace(), u.GetName()}, "/"),
+			group:        group,
+			controlPlane: controlPlane,
+		})
+	}
+}
+
+// Evaluate runs the query against the objects of the control planes in
+// scope. Freshness is ignored because objects in memory are always fresh.
+// Cursors are only valid for the evaluator that returned them.
+func (e *Evaluator) Evaluate(scope query.Scope, spec *queryv1alpha2.QuerySpec) (*queryv1alpha2.QueryResponse, error) {
+	if spec == nil {
+		return nil, errors.New(errNoSpec)
+	}
+	if scope.ControlPlane != "" && scope.Group == "" {
+		return nil, errors.New(errNoGroup)
+	}
+	ms, err := e.compile(spec.Filter.Objects)
+	if err != nil {
+		return nil, err
+	}
+	cp := spec.Filter.ControlPlane
+	var matched []*object
+	for _, o := range e.objects {
+		if !matchString(scope.Group, o.group) || !matchString(scope.ControlPlane, o.controlPlane) {
+			continue
+		}
+		if !matchString(cp.Group, o.group) || !matchString(cp.Name, o.controlPlane) {
+			continue
+		}
+		if matchAny(ms, o) {
+			matched = append(matched, o)
+		}
+	}
+	limit, err := limitOf(spec.Limit)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.respond(matched, spec.QueryResources, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &queryv1alpha2.QueryResponse{QueryResponseObjects: *res}, nil
+}
+
+func matchString(want, got string) bool {
+	return want == "" || want == got
+}
+
+func limitOf(l int) (int, error) {
+	switch {
+	case l < 0:
+		return 0, errors.New(errNegativeLimit)
+	case l == 0:
+		return DefaultLimit, nil
+	default:
+		return l, nil
+	}
+}
+
+// respond orders and pages the matching objects, and renders the page as
+// selected by the query.
+func (e *Evaluator) respond(objs []*object, r queryv1alpha2.QueryResources, limit int) (*queryv1alpha2.QueryResponseObjects, error) {
+	cmp, err := compare(r.Order)
+	if err != nil {
+		return nil, err
+	}
+	objs = slices.Clone(objs)
+	slices.SortStableFunc(objs, cmp)
+
+	if r.Page.First < 0 {
+		return nil, errors.New(errNegativeFirst)
+	}
+	start := 0
+	if r.Page.Cursor != "" {
+		start = slices.IndexFunc(objs, func(o *object) bool { return o.id == r.Page.Cursor })
+		if start < 0 {
+			return nil, errors.Errorf(errFmtUnknownCursor, r.Page.Cursor)
+		}
+	}
+	start = min(start+r.Page.First, len(objs))
+	end := min(start+limit, len(objs))
+
+	res := &queryv1alpha2.QueryResponseObjects{Incomplete: start > 0 || end < len(objs)}
+	if r.Count {
+		n := len(objs) - start
+		res.Count = &n
+	}
+	if r.Cursor {
+		res.Cursor = &queryv1alpha2.QueryResponseCursor{Position: start, PageSize: limit}
+		if limit > 0 {
+			res.Cursor.Page = start / limit
+		}
+		if end < len(objs) {
+			res.Cursor.Next = objs[end].id
+		}
+	}
+	if r.Objects == nil {
+		return res, nil
+	}
+	page := objs[start:end]
+	if r.Objects.Table != nil {
+		res.Tables = tables(page)
+		return res, nil
+	}
+	if res.Objects, err = e.render(page, r.Objects); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// render returns the selected parts of the objects, and their relations.
+func (e *Evaluator) render(page []*object, sel *queryv1alpha2.QueryObjects) ([]queryv1alpha2.QueryResponseObject, error) {
+	var skeleton any
+	if sel.Object != nil {
+		skeleton = sel.Object.Object
+		if err := validateSkeleton(skeleton); err != nil {
+			return nil, err
+		}
+	}
+	out := make([]queryv1alpha2.QueryResponseObject, len(page))
+	for i, o := range page {
+		if sel.ID {
+			out[i].ID = o.id
+		}
+		if sel.MutablePath {
+			out[i].MutablePath = mutablePath(o)
+		}
+		if sel.ControlPlane {
+			out[i].ControlPlane = &queryv1alpha2.QueryResponseControlPlane{Name: o.controlPlane, Namespace: o.group}
+		}
+		if skeleton != nil {
+			obj, _ := sparse(o.Object, skeleton)
+			m, _ := obj.(map[string]any)
+			out[i].Object = &common.JSONObject{Object: m}
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(sel.Relations)) {
+		rels, err := e.relate(page, name, sel.Relations[name])
+		if err != nil {
+			return nil, errors.Wrapf(err, errFmtRelation, name)
+		}
+		for i := range out {
+			if out[i].Relations == nil {
+				out[i].Relations = map[string]queryv1alpha2.QueryResponseRelation{}
+			}
+			out[i].Relations[name] = rels[i]
+		}
+	}
+	return out, nil
+}
+
+func mutablePath(o *object) *queryv1alpha2.QueryResponseMutablePath {
+	gvr, _ := meta.UnsafeGuessKindToResource(o.GroupVersionKind())
+	return &queryv1alpha2.QueryResponseMutablePath{
+		BasePath:             "/" + path.Join("apis", spacesv1beta1.Group, spacesv1beta1.Version, "namespaces", o.group, "controlplanes", o.controlPlane, "k8s"),
+		GroupVersionResource: metav1.GroupVersionResource{Group: gvr.Group, Version: gvr.Version, Resource: gvr.Resource},
+	}
+}
+
+// validateSkeleton returns an error if the skeleton has leaves other than
+// true.
+func validateSkeleton(skeleton any) error {
+	switch s := skeleton.(type) {
+	case bool:
+		if s {
+			return nil
+		}
+	case map[string]any:
+		for _, v := range s {
+			if err := validateSkeleton(v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return errors.New(errInvalidSkeleton)
+}
+
+// sparse returns the fields of v selected by the skeleton. Objects in arrays
+// are each selected by the skeleton. It returns false if v is not an object
+// or array where the skeleton expects one.
+func sparse(v, skeleton any) (any, bool) {
+	s, ok := skeleton.(map[string]any)
+	if !ok {
+		return runtime.DeepCopyJSONValue(v), true
+	}
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(s))
+		for k, cs := range s {
+			cv, ok := v[k]
+			if !ok {
+				continue
+			}
+			if sv, ok := sparse(cv, cs); ok {
+				out[k] = sv
+			}
+		}
+		return out, true
+	case []any:
+		out := make([]any, 0, len(v))
+		for _, cv := range v {
+			if sv, ok := sparse(cv, s); ok {
+				out = append(out, sv)
+			}
+		}
+		return out, true
+	default:
+		return nil, false
+	}
+}
+
+// compare returns a function that orders objects as specified, then by
+// control plane and object identity so the order is always total.
+func compare(orders []queryv1alpha2.QueryOrder) (func(a, b *object) int, error) {
+	keys := make([]orderKey, 0, len(orders)+6)
+	for i, o := range orders {
+		var set []orderKey
+		for _, k := range []orderKey{
+			{o.CreationTimestamp, byCreationTimestamp},
+			{o.Name, byName},
+			{o.Namespace, byNamespace},
+			{o.APIGroup, byAPIGroup},
+			{o.Kind, byKind},
+			{o.Group, byGroup},
+			{o.ControlPlane, byControlPlane},
+		} {
+			if k.dir != "" {
+				set = append(set, k)
+			}
+		}
+		if len(set) != 1 {
+			return nil, errors.Errorf(errFmtOrderFields, i)
+		}
+		if set[0].dir != queryv1alpha2.Ascending && set[0].dir != queryv1alpha2.Descending {
+			return nil, errors.Errorf(errFmtDirection, i, set[0].dir)
+		}
+		keys = append(keys, set[0])
+	}
+	for _, c := range []func(a, b *object) int{byGroup, byControlPlane, byAPIGroup, byKind, byNamespace, byName} {
+		keys = append(keys, orderKey{queryv1alpha2.Ascending, c})
+	}
+	return func(a, b *object) int {
+		for _, k := range keys {
+			c := k.cmp(a, b)
+			if k.dir == queryv1alpha2.Descending {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return 0
+	}, nil
+}
+
+type orderKey struct {
+	dir queryv1alpha2.Direction
+	cmp func(a, b *object) int
+}
+
+func byCreationTimestamp(a, b *object) int {
+	return a.GetCreationTimestamp().Compare(b.GetCreationTimestamp().Time)
+}
+
+func byName(a, b *object) int {
+	return strings.Compare(a.GetName(), b.GetName())
+}
+
+func byNamespace(a, b *object) int {
+	return strings.Compare(a.GetNamespace(), b.GetNamespace())
+}
+
+func byAPIGroup(a, b *object) int {
+	return strings.Compare(a.GroupVersionKind().Group, b.GroupVersionKind().Group)
+}
+
+func byKind(a, b *object) int {
+	return strings.Compare(a.GetKind(), b.GetKind())
+}
+
+func byGroup(a, b *object) int {
+	return strings.Compare(a.group, b.group)
+}
+
+func byControlPlane(a, b *object) int {
+	return strings.Compare(a.controlPlane, b.controlPlane)
+}
+
+// tables returns the objects as one table per kind, with the columns the
+// Kubernetes API returns for kinds without additional printer columns.
+func tables(page []*object) []queryv1alpha2.QueryResponseTable {
+	var out []queryv1alpha2.QueryResponseTable
+	index := map[schema.GroupVersionKind]int{}
+	for _, o := range page {
+		gvk := o.GroupVersionKind()
+		i, ok := index[gvk]
+		if !ok {
+			i = len(out)
+			index[gvk] = i
+			out = append(out, queryv1alpha2.QueryResponseTable{
+				GroupVersionKind: metav1.GroupVersionKind{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind},
+				Columns: []metav1.TableColumnDefinition{
+					{Name: "Name", Type: "string", Format: "name", Description: fmt.Sprintf("Name of the %s.", gvk.Kind)},
+					{Name: "Created At", Type: "date", Description: "Time the object was created."},
+				},
+				Rows: []metav1.TableRow{},
+			})
+		}
+		out[i].Rows = append(out[i].Rows, metav1.TableRow{
+			Cells: []any{o.GetName(), o.GetCreationTimestamp().UTC().Format(time.RFC3339)},
+		})
+	}
+	return out
+}
@@ -0,0 +1,264 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+	"github.com/upbound/up-sdk-go/service/query"
+)
+
+func newObject(apiVersion, kind, namespace, name string, mods ...func(u *unstructured.Unstructured)) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	u.SetNamespace(namespace)
+	u.SetName(name)
+	for _, m := range mods {
+		m(u)
+	}
+	return u
+}
+
+func withUID(uid string) func(u *unstructured.Unstructured) {
+	return func(u *unstructured.Unstructured) { u.SetUID(types.UID(uid)) }
+}
+
+func withCreated(t time.Time) func(u *unstructured.Unstructured) {
+	return func(u *unstructured.Unstructured) { u.SetCreationTimestamp(metav1.NewTime(t)) }
+}
+
+func withField(value any, fields ...string) func(u *unstructured.Unstructured) {
+	return func(u *unstructured.Unstructured) {
+		if err := unstructured.SetNestedField(u.Object, value, fields...); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func composition(name string, created time.Time, mods ...func(u *unstructured.Unstructured)) *unstructured.Unstructured {
+	return newObject("apiextensions.crossplane.io/v1", "Composition", "", name, append(mods, withCreated(created))...)
+}
+
+func TestEvaluate(t *testing.T) {
+	t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := New()
+	e.Add("default", "a",
+		composition("b-comp", t0.Add(time.Hour)),
+		composition("a-comp", t0.Add(2*time.Hour)),
+		newObject("v1", "ConfigMap", "x", "cm", withCreated(t0)),
+	)
+	e.Add("default", "b", composition("c-comp", t0, withField([]any{map[string]any{"type": "Ready", "status": "True"}}, "status", "conditions")))
+	e.Add("other", "c", composition("d-comp", t0.Add(3*time.Hour)))
+
+	const (
+		cm    = "default/a//ConfigMap/x/cm"
+		aComp = "default/a/apiextensions.crossplane.io/Composition//a-comp"
+		bComp = "default/a/apiextensions.crossplane.io/Composition//b-comp"
+		cComp = "default/b/apiextensions.crossplane.io/Composition//c-comp"
+		dComp = "other/c/apiextensions.crossplane.io/Composition//d-comp"
+	)
+	ids := func(ids ...string) []queryv1alpha2.QueryResponseObject {
+		objs := make([]queryv1alpha2.QueryResponseObject, len(ids))
+		for i, id := range ids {
+			objs[i].ID = id
+		}
+		return objs
+	}
+	compositions := queryv1alpha2.QueryTopLevelFilter{Objects: []queryv1alpha2.QueryFilter{{GroupKind: queryv1alpha2.QueryGroupKind{Kind: "compositions"}}}}
+	count := func(n int) *int { return &n }
+
+	type args struct {
+		scope query.Scope
+		spec  *queryv1alpha2.QuerySpec
+	}
+	type want struct {
+		res *queryv1alpha2.QueryResponse
+		err error
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Scope": {
+			reason: "Only objects of control planes in scope and matching the control plane filter should be returned, in the default order.",
+			args: args{
+				scope: query.Scope{Group: "default"},
+				spec: &queryv1alpha2.QuerySpec{QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+					QueryResources: queryv1alpha2.QueryResources{Objects: &queryv1alpha2.QueryObjects{ID: true}},
+					Filter:         queryv1alpha2.QueryTopLevelFilter{ControlPlane: queryv1alpha2.QueryFilterControlPlane{Name: "a"}},
+				}},
+			},
+			want: want{res: &queryv1alpha2.QueryResponse{QueryResponseObjects: queryv1alpha2.QueryResponseObjects{
+				Objects: ids(cm, aComp, bComp),
+			}}},
+		},
+		"OrderAndPage": {
+			reason: "Objects should be ordered, skipped and limited, with a count of the remaining objects and a cursor to the next page.",
+			args: args{spec: &queryv1alpha2.QuerySpec{QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+				QueryResources: queryv1alpha2.QueryResources{
+					Objects: &queryv1alpha2.QueryObjects{ID: true},
+					Order:   []queryv1alpha2.QueryOrder{{CreationTimestamp: queryv1alpha2.Descending}},
+					Limit:   2,
+					Page:    queryv1alpha2.QueryPage{First: 1},
+					Count:   true,
+					Cursor:  true,
+				},
+				Filter: compositions,
+			}}},
+			want: want{res: &queryv1alpha2.QueryResponse{QueryResponseObjects: queryv1alpha2.QueryResponseObjects{
+				Objects:    ids(aComp, bComp),
+				Count:      count(3),
+				Cursor:     &queryv1alpha2.QueryResponseCursor{Next: cComp, PageSize: 2, Position: 1},
+				Incomplete: true,
+			}}},
+		},
+		"Cursor": {
+			reason: "A cursor should start the page at the object it points to.",
+			args: args{spec: &queryv1alpha2.QuerySpec{QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+				QueryResources: queryv1alpha2.QueryResources{
+					Objects: &queryv1alpha2.QueryObjects{ID: true},
+					Page:    queryv1alpha2.QueryPage{Cursor: bComp},
+					Cursor:  true,
+				},
+				Filter: compositions,
+			}}},
+			want: want{res: &queryv1alpha2.QueryResponse{QueryResponseObjects: queryv1alpha2.QueryResponseObjects{
+				Objects:    ids(bComp, cComp, dComp),
+				Cursor:     &queryv1alpha2.QueryResponseCursor{PageSize: DefaultLimit, Position: 1},
+				Incomplete: true,
+			}}},
+		},
+		"Select": {
+			reason: "The mutable path, control plane and sparse object should be returned as selected.",
+			args: args{
+				scope: query.Scope{Group: "default", ControlPlane: "b"},
+				spec: &queryv1alpha2.QuerySpec{QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+					QueryResources: queryv1alpha2.QueryResources{Objects: &queryv1alpha2.QueryObjects{
+						MutablePath:  true,
+						ControlPlane: true,
+						Object: &common.JSON{Object: map[string]any{
+							"metadata": map[string]any{"name": true},
+							"spec":     true,
+							"status":   map[string]any{"conditions": map[string]any{"type": true}},
+						}},
+					}},
+				}},
+			},
+			want: want{res: &queryv1alpha2.QueryResponse{QueryResponseObjects: queryv1alpha2.QueryResponseObjects{
+				Objects: []queryv1alpha2.QueryResponseObject{{
+					MutablePath: &queryv1alpha2.QueryResponseMutablePath{
+						BasePath:             "/apis/spaces.upbound.io/v1beta1/namespaces/default/controlplanes/b/k8s",
+						GroupVersionResource: metav1.GroupVersionResource{Group: "apiextensions.crossplane.io", Version: "v1", Resource: "compositions"},
+					},
+					ControlPlane: &queryv1alpha2.QueryResponseControlPlane{Name: "b", Namespace: "default"},
+					Object: &common.JSONObject{Object: map[string]any{
+						"metadata": map[string]any{"name": "c-comp"},
+						"status":   map[string]any{"conditions": []any{map[string]any{"type": "Ready"}}},
+					}},
+				}},
+			}}},
+		},
+		"Table": {
+			reason: "Objects should be returned as one table per kind.",
+			args: args{
+				scope: query.Scope{Group: "default", ControlPlane: "a"},
+				spec: &queryv1alpha2.QuerySpec{QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+					QueryResources: queryv1alpha2.QueryResources{Objects: &queryv1alpha2.QueryObjects{Table: &queryv1alpha2.QueryTable{}}},
+				}},
+			},
+			want: want{res: &queryv1alpha2.QueryResponse{QueryResponseObjects: queryv1alpha2.QueryResponseObjects{
+				Tables: []queryv1alpha2.QueryResponseTable{
+					{
+						GroupVersionKind: metav1.GroupVersionKind{Version: "v1", Kind: "ConfigMap"},
+						Columns: []metav1.TableColumnDefinition{
+							{Name: "Name", Type: "string", Format: "name", Description: "Name of the ConfigMap."},
+							{Name: "Created At", Type: "date", Description: "Time the object was created."},
+						},
+						Rows: []metav1.TableRow{{Cells: []any{"cm", "2025-01-01T00:00:00Z"}}},
+					},
+					{
+						GroupVersionKind: metav1.GroupVersionKind{Group: "apiextensions.crossplane.io", Version: "v1", Kind: "Composition"},
+						Columns: []metav1.TableColumnDefinition{
+							{Name: "Name", Type: "string", Format: "name", Description: "Name of the Composition."},
+							{Name: "Created At", Type: "date", Description: "Time the object was created."},
+						},
+						Rows: []metav1.TableRow{
+							{Cells: []any{"a-comp", "2025-01-01T02:00:00Z"}},
+							{Cells: []any{"b-comp", "2025-01-01T01:00:00Z"}},
+						},
+					},
+				},
+			}}},
+		},
+		"NoGroup": {
+			reason: "A control plane scope without a group should return an error.",
+			args:   args{scope: query.Scope{ControlPlane: "a"}, spec: &queryv1alpha2.QuerySpec{}},
+			want:   want{err: errors.New(errNoGroup)},
+		},
+		"InvalidOrder": {
+			reason: "An order without a field should return an error.",
+			args: args{spec: &queryv1alpha2.QuerySpec{QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+				QueryResources: queryv1alpha2.QueryResources{Order: []queryv1alpha2.QueryOrder{{}}},
+			}}},
+			want: want{err: errors.Errorf(errFmtOrderFields, 0)},
+		},
+		"UnknownCursor": {
+			reason: "A cursor to an unknown object should return an error.",
+			args: args{spec: &queryv1alpha2.QuerySpec{QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+				QueryResources: queryv1alpha2.QueryResources{Page: queryv1alpha2.QueryPage{Cursor: "nope"}},
+			}}},
+			want: want{err: errors.Errorf(errFmtUnknownCursor, "nope")},
+		},
+		"InvalidSkeleton": {
+			reason: "A skeleton with a leaf other than true should return an error.",
+			args: args{spec: &queryv1alpha2.QuerySpec{QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+				QueryResources: queryv1alpha2.QueryResources{Objects: &queryv1alpha2.QueryObjects{
+					Object: &common.JSON{Object: map[string]any{"metadata": "name"}},
+				}},
+			}}},
+			want: want{err: errors.New(errInvalidSkeleton)},
+		},
+		"NegativeLimit": {
+			reason: "A negative limit should return an error.",
+			args: args{spec: &queryv1alpha2.QuerySpec{QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+				QueryResources: queryv1alpha2.QueryResources{Limit: -1},
+			}}},
+			want: want{err: errors.New(errNegativeLimit)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := e.Evaluate(tc.args.scope, tc.args.spec)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nEvaluate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.res, got); diff != "" {
+				t.Errorf("\n%s\nEvaluate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
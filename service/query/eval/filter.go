@@ -0,0 +1,181 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eval
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/theory/jsonpath"
+	"github.com/theory/jsonpath/spec"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+const (
+	errEmptyCondition     = "condition type must not be empty"
+	errFmtInvalidJSONPath = "invalid jsonpath filter %q"
+	errFmtFilter          = "invalid filter %d"
+)
+
+// A matcher matches objects against a filter.
+type matcher struct {
+	queryv1alpha2.QueryFilter
+
+	// jsonpath is the compiled JSONPath of the filter, if any.
+	jsonpath *spec.FilterSelector
+
+	// categories maps kinds to their categories.
+	categories map[schema.GroupKind][]string
+}
+
+// compile returns matchers for the filters, or an error if any of them is
+// invalid.
+func (e *Evaluator) compile(filters []queryv1alpha2.QueryFilter) ([]matcher, error) {
+	ms := make([]matcher, len(filters))
+	for i, f := range filters {
+		for _, c := range f.Conditions {
+			if c.Type == "" {
+				return nil, errors.Wrapf(errors.New(errEmptyCondition), errFmtFilter, i)
+			}
+		}
+		ms[i] = matcher{QueryFilter: f, categories: e.categories}
+		if f.JSONPath == "" {
+			continue
+		}
+		fs, err := compileJSONPath(f.JSONPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, errFmtFilter, i)
+		}
+		ms[i].jsonpath = fs
+	}
+	return ms, nil
+}
+
+// compileJSONPath compiles an RFC 9535 filter expression, e.g.
+// @.spec.replicas > 2. Both @ and $ refer to the object when it is evaluated.
+// A leading ? is optional.
+func compileJSONPath(expr string) (*spec.FilterSelector, error) {
+	p, err := jsonpath.Parse("$[?" + strings.TrimPrefix(expr, "?") + "]")
+	if err != nil {
+		return nil, errors.Wrapf(err, errFmtInvalidJSONPath, expr)
+	}
+	// Reject expressions that close the filter early, e.g. "true][0".
+	segs := p.Query().Segments()
+	if len(segs) != 1 || len(segs[0].Selectors()) != 1 {
+		return nil, errors.Errorf(errFmtInvalidJSONPath, expr)
+	}
+	fs, ok := segs[0].Selectors()[0].(*spec.FilterSelector)
+	if !ok {
+		return nil, errors.Errorf(errFmtInvalidJSONPath, expr)
+	}
+	return fs, nil
+}
+
+// matchAny returns true if the object matches any of the matchers, or if
+// there are none.
+func matchAny(ms []matcher, o *object) bool {
+	if len(ms) == 0 {
+		return true
+	}
+	for _, m := range ms {
+		if m.match(o) {
+			return true
+		}
+	}
+	return false
+}
+
+// match returns true if the object matches every criterion of the filter.
+func (m matcher) match(o *object) bool { //nolint:gocyclo // Each criterion is a simple check.
+	gvk := o.GroupVersionKind()
+	switch {
+	case m.ID != "" && m.ID != o.id:
+		return false
+	case !matchString(m.Namespace, o.GetNamespace()):
+		return false
+	case !matchString(m.Name, o.GetName()):
+		return false
+	case !matchString(m.GroupKind.APIGroup, gvk.Group):
+		return false
+	}
+	if m.GroupKind.Kind != "" {
+		plural, _ := meta.UnsafeGuessKindToResource(gvk)
+		if !strings.EqualFold(m.GroupKind.Kind, gvk.Kind) && !strings.EqualFold(m.GroupKind.Kind, plural.Resource) {
+			return false
+		}
+	}
+	labels := o.GetLabels()
+	for k, v := range m.Labels {
+		if got, ok := labels[k]; !ok || got != v {
+			return false
+		}
+	}
+	if len(m.Categories) > 0 && !m.inCategory(gvk.GroupKind()) {
+		return false
+	}
+	for _, c := range m.Conditions {
+		if !hasCondition(o, c) {
+			return false
+		}
+	}
+	created := o.GetCreationTimestamp()
+	if after := m.CreationTimestamp.After; !after.IsZero() && !created.After(after.Time) {
+		return false
+	}
+	if before := m.CreationTimestamp.Before; !before.IsZero() && !created.Before(&before) {
+		return false
+	}
+	if m.jsonpath != nil && !m.jsonpath.Eval(o.Object, o.Object) {
+		return false
+	}
+	return true
+}
+
+// inCategory returns true if the kind is in any of the filter's categories.
+func (m matcher) inCategory(gk schema.GroupKind) bool {
+	for _, c := range m.categories[gk] {
+		for _, want := range m.Categories {
+			if strings.EqualFold(c, want) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasCondition returns true if the object has a condition of the supplied
+// type, with the supplied status and reason if they are set.
+func hasCondition(o *object, want queryv1alpha2.QueryCondition) bool {
+	conds, _, _ := unstructured.NestedFieldNoCopy(o.Object, "status", "conditions")
+	l, _ := conds.([]any)
+	for _, c := range l {
+		c, ok := c.(map[string]any)
+		if !ok || c["type"] != want.Type {
+			continue
+		}
+		if want.Status != "" && c["status"] != want.Status {
+			continue
+		}
+		if want.Reason != "" && c["reason"] != want.Reason {
+			continue
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+func TestMatch(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := New(WithCategories(schema.GroupKind{Group: "ec2.aws.upbound.io", Kind: "VPC"}, "managed", "aws"))
+	e.Add("default", "ctp", newObject("ec2.aws.upbound.io/v1beta1", "VPC", "", "vpc",
+		withCreated(created),
+		func(u *unstructured.Unstructured) { u.SetLabels(map[string]string{"env": "prod"}) },
+		withField(int64(2), "spec", "forProvider", "replicas"),
+		withField([]any{map[string]any{"type": "Ready", "status": "False", "reason": "Creating"}}, "status", "conditions"),
+	))
+
+	type want struct {
+		match bool
+		err   bool
+	}
+	cases := map[string]struct {
+		reason string
+		f      queryv1alpha2.QueryFilter
+		want   want
+	}{
+		"Empty": {
+			reason: "An empty filter should match every object.",
+			want:   want{match: true},
+		},
+		"Identity": {
+			reason: "The ID, name and API group should match exactly.",
+			f: queryv1alpha2.QueryFilter{
+				ID:        "default/ctp/ec2.aws.upbound.io/VPC//vpc",
+				Name:      "vpc",
+				GroupKind: queryv1alpha2.QueryGroupKind{APIGroup: "ec2.aws.upbound.io"},
+			},
+			want: want{match: true},
+		},
+		"Namespace": {
+			reason: "A cluster scoped object should not match a namespace.",
+			f:      queryv1alpha2.QueryFilter{Namespace: "default"},
+			want:   want{match: false},
+		},
+		"KindPlural": {
+			reason: "Kinds should match case-insensitively and by plural resource.",
+			f:      queryv1alpha2.QueryFilter{GroupKind: queryv1alpha2.QueryGroupKind{Kind: "vpcs"}},
+			want:   want{match: true},
+		},
+		"Labels": {
+			reason: "Every label should match.",
+			f:      queryv1alpha2.QueryFilter{Labels: map[string]string{"env": "prod", "team": "a"}},
+			want:   want{match: false},
+		},
+		"Category": {
+			reason: "A kind should match any of its categories.",
+			f:      queryv1alpha2.QueryFilter{Categories: []string{"composite", "managed"}},
+			want:   want{match: true},
+		},
+		"UnknownCategory": {
+			reason: "A kind should not match categories it was not added to.",
+			f:      queryv1alpha2.QueryFilter{Categories: []string{"composite"}},
+			want:   want{match: false},
+		},
+		"Condition": {
+			reason: "A condition should match by type, status and reason.",
+			f:      queryv1alpha2.QueryFilter{Conditions: []queryv1alpha2.QueryCondition{{Type: "Ready", Status: "False", Reason: "Creating"}}},
+			want:   want{match: true},
+		},
+		"ConditionStatus": {
+			reason: "A condition with a different status should not match.",
+			f:      queryv1alpha2.QueryFilter{Conditions: []queryv1alpha2.QueryCondition{{Type: "Ready", Status: "True"}}},
+			want:   want{match: false},
+		},
+		"CreationTimestamp": {
+			reason: "An object created within the range should match.",
+			f: queryv1alpha2.QueryFilter{CreationTimestamp: queryv1alpha2.QueryCreationTimestamp{
+				After:  metav1.NewTime(created.Add(-time.Hour)),
+				Before: metav1.NewTime(created.Add(time.Hour)),
+			}},
+			want: want{match: true},
+		},
+		"CreatedBefore": {
+			reason: "An object created after the end of the range should not match.",
+			f:      queryv1alpha2.QueryFilter{CreationTimestamp: queryv1alpha2.QueryCreationTimestamp{Before: metav1.NewTime(created)}},
+			want:   want{match: false},
+		},
+		"JSONPath": {
+			reason: "A JSONPath filter expression should be evaluated against the object.",
+			f:      queryv1alpha2.QueryFilter{JSONPath: `@.spec.forProvider.replicas > 1 && $.metadata.name == "vpc"`},
+			want:   want{match: true},
+		},
+		"JSONPathFalse": {
+			reason: "An object for which the JSONPath expression is false should not match.",
+			f:      queryv1alpha2.QueryFilter{JSONPath: `?@.spec.forProvider.replicas > 2`},
+			want:   want{match: false},
+		},
+		"InvalidJSONPath": {
+			reason: "An expression that is not a single filter expression should return an error.",
+			f:      queryv1alpha2.QueryFilter{JSONPath: `true][0`},
+			want:   want{err: true},
+		},
+		"EmptyCondition": {
+			reason: "A condition without a type should return an error.",
+			f:      queryv1alpha2.QueryFilter{Conditions: []queryv1alpha2.QueryCondition{{Status: "True"}}},
+			want:   want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ms, err := e.compile([]queryv1alpha2.QueryFilter{tc.f})
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("\n%s\ncompile(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.match, matchAny(ms, e.objects[0])); diff != "" {
+				t.Errorf("\n%s\nmatch(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eval
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+// Relations the evaluator can follow. A relation name suffixed with + follows
+// the relation transitively, e.g. owners+.
+const (
+	// RelationOwners are the objects listed in an object's owner references.
+	RelationOwners = "owners"
+
+	// RelationDescendants are the objects that list an object in their owner
+	// references.
+	RelationDescendants = "descendants"
+
+	// RelationResources are the objects a Crossplane composite resource or
+	// claim references as its resources.
+	RelationResources = "resources"
+
+	// RelationEvents are the events about an object.
+	RelationEvents = "events"
+
+	errFmtUnknownRelation = "unknown relation %q"
+)
+
+// A resolver returns the objects related to an object.
+type resolver func(o *object) []*object
+
+// relate returns the related objects of every object in the page, in the
+// order of the page. The relation's limit applies across all of them, so
+// objects late in the page may get fewer related objects than early ones.
+func (e *Evaluator) relate(page []*object, name string, rel queryv1alpha2.QueryRelation) ([]queryv1alpha2.QueryResponseRelation, error) {
+	resolve, err := e.resolver(name)
+	if err != nil {
+		return nil, err
+	}
+	ms, err := e.compile(rel.Filters)
+	if err != nil {
+		return nil, err
+	}
+	remaining, err := limitOf(rel.Limit)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]queryv1alpha2.QueryResponseRelation, len(page))
+	for i, o := range page {
+		var matched []*object
+		for _, r := range resolve(o) {
+			if matchAny(ms, r) {
+				matched = append(matched, r)
+			}
+		}
+		res, err := e.respond(matched, rel.QueryResources, remaining)
+		if err != nil {
+			return nil, err
+		}
+		remaining -= len(res.Objects)
+		for _, t := range res.Tables {
+			remaining -= len(t.Rows)
+		}
+		out[i] = queryv1alpha2.QueryResponseRelation{QueryResponseObjects: *res}
+	}
+	return out, nil
+}
+
+// resolver returns a resolver for the named relation.
+func (e *Evaluator) resolver(name string) (resolver, error) {
+	base, transitive := strings.CutSuffix(name, "+")
+	var r resolver
+	switch base {
+	case RelationOwners:
+		r = e.owners
+	case RelationDescendants:
+		r = e.descendants
+	case RelationResources:
+		r = e.resources
+	case RelationEvents:
+		r = e.events
+	default:
+		return nil, errors.Errorf(errFmtUnknownRelation, name)
+	}
+	if transitive {
+		return closure(r), nil
+	}
+	return r, nil
+}
+
+// closure returns a resolver that follows r transitively, breadth first. An
+// object is never related to itself, and related objects are returned once.
+func closure(r resolver) resolver {
+	return func(o *object) []*object {
+		seen := map[*object]bool{o: true}
+		var out []*object
+		for queue := r(o); len(queue) > 0; queue = queue[1:] {
+			n := queue[0]
+			if seen[n] {
+				continue
+			}
+			seen[n] = true
+			out = append(out, n)
+			queue = append(queue, r(n)...)
+		}
+		return out
+	}
+}
+
+// find returns the objects in the object's control plane that satisfy fn.
+func (e *Evaluator) find(o *object, fn func(c *object) bool) []*object {
+	var out []*object
+	for _, c := range e.objects {
+		if c.sameControlPlane(o) && fn(c) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func (e *Evaluator) owners(o *object) []*object {
+	var out []*object
+	for _, ref := range o.GetOwnerReferences() {
+		out = append(out, e.find(o, func(c *object) bool { return c.GetUID() == ref.UID })...)
+	}
+	return out
+}
+
+func (e *Evaluator) descendants(o *object) []*object {
+	if o.GetUID() == "" {
+		return nil
+	}
+	return e.find(o, func(c *object) bool {
+		for _, ref := range c.GetOwnerReferences() {
+			if ref.UID == o.GetUID() {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// resources follows the resource references of Crossplane composite
+// resources and claims. A reference without a namespace matches objects in
+// the referencing object's namespace or cluster scoped objects.
+func (e *Evaluator) resources(o *object) []*object {
+	var refs []any
+	for _, p := range [][]string{{"spec", "resourceRefs"}, {"spec", "crossplane", "resourceRefs"}} {
+		l, _, _ := unstructured.NestedFieldNoCopy(o.Object, p...)
+		s, _ := l.([]any)
+		refs = append(refs, s...)
+	}
+	if ref, _, _ := unstructured.NestedFieldNoCopy(o.Object, "spec", "resourceRef"); ref != nil {
+		refs = append(refs, ref)
+	}
+
+	var out []*object
+	for _, ref := range refs {
+		ref, ok := ref.(map[string]any)
+		if !ok {
+			continue
+		}
+		apiVersion, _ := ref["apiVersion"].(string)
+		kind, _ := ref["kind"].(string)
+		name, _ := ref["name"].(string)
+		namespace, _ := ref["namespace"].(string)
+		gv, err := schema.ParseGroupVersion(apiVersion)
+		if err != nil || kind == "" || name == "" {
+			continue
+		}
+		out = append(out, e.find(o, func(c *object) bool {
+			gvk := c.GroupVersionKind()
+			if gvk.Group != gv.Group || gvk.Kind != kind || c.GetName() != name {
+				return false
+			}
+			if namespace != "" {
+				return c.GetNamespace() == namespace
+			}
+			return c.GetNamespace() == o.GetNamespace() || c.GetNamespace() == ""
+		})...)
+	}
+	return out
+}
+
+// events returns core and events.k8s.io events that involve the object.
+func (e *Evaluator) events(o *object) []*object {
+	if o.GetUID() == "" {
+		return nil
+	}
+	return e.find(o, func(c *object) bool {
+		gvk := c.GroupVersionKind()
+		if gvk.Kind != "Event" || (gvk.Group != "" && gvk.Group != "events.k8s.io") {
+			return false
+		}
+		for _, f := range []string{"involvedObject", "regarding"} {
+			if uid, _, _ := unstructured.NestedString(c.Object, f, "uid"); uid == string(o.GetUID()) {
+				return true
+			}
+		}
+		return false
+	})
+}
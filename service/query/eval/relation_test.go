@@ -0,0 +1,149 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eval
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+	"github.com/upbound/up-sdk-go/service/query"
+)
+
+func withOwner(uid string) func(u *unstructured.Unstructured) {
+	return func(u *unstructured.Unstructured) {
+		u.SetOwnerReferences(append(u.GetOwnerReferences(), metav1.OwnerReference{UID: types.UID(uid)}))
+	}
+}
+
+func ref(apiVersion, kind, name string) map[string]any {
+	return map[string]any{"apiVersion": apiVersion, "kind": kind, "name": name}
+}
+
+func TestRelations(t *testing.T) {
+	e := New()
+	e.Add("default", "ctp",
+		newObject("example.org/v1", "Network", "team", "net", withUID("claim"),
+			withField(ref("example.org/v1", "XNetwork", "net-x"), "spec", "resourceRef")),
+		newObject("example.org/v1", "XNetwork", "", "net-x", withUID("xr"),
+			withField([]any{ref("ec2.aws.upbound.io/v1beta1", "VPC", "vpc"), ref("ec2.aws.upbound.io/v1beta1", "Subnet", "subnet")}, "spec", "resourceRefs")),
+		newObject("ec2.aws.upbound.io/v1beta1", "VPC", "", "vpc", withUID("vpc"), withOwner("xr")),
+		newObject("ec2.aws.upbound.io/v1beta1", "Subnet", "", "subnet", withUID("subnet"), withOwner("xr"), withOwner("vpc")),
+		newObject("v1", "Event", "default", "vpc.1", withField("vpc", "involvedObject", "uid")),
+	)
+	// An object with the same UID in another control plane is never related.
+	e.Add("default", "other", newObject("ec2.aws.upbound.io/v1beta1", "VPC", "", "vpc", withUID("vpc"), withOwner("xr")))
+
+	names := func(rel queryv1alpha2.QueryResponseRelation) []string {
+		var out []string
+		for _, o := range rel.Objects {
+			out = append(out, o.Object.Object["metadata"].(map[string]any)["name"].(string))
+		}
+		return out
+	}
+	nameOnly := &queryv1alpha2.QueryObjects{Object: &common.JSON{Object: map[string]any{"metadata": map[string]any{"name": true}}}}
+
+	type args struct {
+		kind string
+		rel  queryv1alpha2.QueryRelation
+	}
+	type want struct {
+		names [][]string
+		err   error
+	}
+	cases := map[string]struct {
+		reason   string
+		relation string
+		args     args
+		want     want
+	}{
+		"Owners": {
+			reason:   "Owners should be the objects in an object's owner references.",
+			relation: "owners",
+			args:     args{kind: "Subnet", rel: queryv1alpha2.QueryRelation{QueryNestedResources: queryv1alpha2.QueryNestedResources{QueryResources: queryv1alpha2.QueryResources{Objects: nameOnly}}}},
+			want:     want{names: [][]string{{"vpc", "net-x"}}},
+		},
+		"Descendants": {
+			reason:   "Descendants should follow owner references transitively, without repeating objects.",
+			relation: "descendants+",
+			args:     args{kind: "XNetwork", rel: queryv1alpha2.QueryRelation{QueryNestedResources: queryv1alpha2.QueryNestedResources{QueryResources: queryv1alpha2.QueryResources{Objects: nameOnly}}}},
+			want:     want{names: [][]string{{"subnet", "vpc"}}},
+		},
+		"Resources": {
+			reason:   "Resources should follow the resource references of claims and composites, filtered as requested.",
+			relation: "resources+",
+			args: args{kind: "Network", rel: queryv1alpha2.QueryRelation{QueryNestedResources: queryv1alpha2.QueryNestedResources{
+				QueryResources: queryv1alpha2.QueryResources{Objects: nameOnly},
+				Filters:        []queryv1alpha2.QueryFilter{{GroupKind: queryv1alpha2.QueryGroupKind{APIGroup: "ec2.aws.upbound.io"}}},
+			}}},
+			want: want{names: [][]string{{"subnet", "vpc"}}},
+		},
+		"Events": {
+			reason:   "Events should be the events involving an object.",
+			relation: "events",
+			args:     args{kind: "VPC", rel: queryv1alpha2.QueryRelation{QueryNestedResources: queryv1alpha2.QueryNestedResources{QueryResources: queryv1alpha2.QueryResources{Objects: nameOnly}}}},
+			want:     want{names: [][]string{{"vpc.1"}}},
+		},
+		"Limit": {
+			reason:   "A relation's limit should apply across all parents.",
+			relation: "owners",
+			args: args{rel: queryv1alpha2.QueryRelation{QueryNestedResources: queryv1alpha2.QueryNestedResources{
+				QueryResources: queryv1alpha2.QueryResources{Objects: nameOnly, Limit: 1},
+			}}},
+			want: want{names: [][]string{{"vpc"}, nil}},
+		},
+		"Unknown": {
+			reason:   "An unknown relation should return an error.",
+			relation: "friends",
+			args:     args{kind: "VPC"},
+			want:     want{err: errors.Wrapf(errors.Errorf(errFmtUnknownRelation, "friends"), errFmtRelation, "friends")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			filter := queryv1alpha2.QueryFilter{GroupKind: queryv1alpha2.QueryGroupKind{Kind: tc.args.kind}}
+			if tc.args.kind == "" {
+				// Every object with owners, in the default order.
+				filter.GroupKind.APIGroup = "ec2.aws.upbound.io"
+			}
+			res, err := e.Evaluate(query.Scope{Group: "default", ControlPlane: "ctp"}, &queryv1alpha2.QuerySpec{QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+				QueryResources: queryv1alpha2.QueryResources{Objects: &queryv1alpha2.QueryObjects{
+					Relations: map[string]queryv1alpha2.QueryRelation{tc.relation: tc.args.rel},
+				}},
+				Filter: queryv1alpha2.QueryTopLevelFilter{Objects: []queryv1alpha2.QueryFilter{filter}},
+			}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nEvaluate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			var got [][]string
+			for _, o := range res.Objects {
+				got = append(got, names(o.Relations[tc.relation]))
+			}
+			if diff := cmp.Diff(tc.want.names, got); diff != "" {
+				t.Errorf("\n%s\nEvaluate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
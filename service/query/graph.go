@@ -0,0 +1,96 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"iter"
+	"maps"
+	"slices"
+
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+// A Node is an object in the graph of objects returned by a query.
+type Node struct {
+	// Object is the object.
+	Object *queryv1alpha2.QueryResponseObject
+
+	// Parent is the node the object is related to, or nil if the object was
+	// returned at the top level of the query.
+	Parent *Node
+
+	// Relation is the name of the relation through which the object is
+	// related to its parent, e.g. owners or events.
+	Relation string
+
+	// Depth is the number of relations between the object and the top level
+	// of the query.
+	Depth int
+}
+
+// Walk returns an iterator over the objects and, depth first, the objects
+// related to them. Relations are visited in name order. An object that
+// appears again among its own relations, e.g. through a transitive relation
+// such as descendants+, is yielded but its relations are not walked again.
+func Walk(objects []queryv1alpha2.QueryResponseObject) iter.Seq[*Node] {
+	return func(yield func(*Node) bool) {
+		for i := range objects {
+			if !walk(&Node{Object: &objects[i]}, yield) {
+				return
+			}
+		}
+	}
+}
+
+func walk(n *Node, yield func(*Node) bool) bool {
+	if !yield(n) {
+		return false
+	}
+	if n.cycle() {
+		return true
+	}
+	for _, name := range slices.Sorted(maps.Keys(n.Object.Relations)) {
+		related := n.Object.Relations[name].Objects
+		for i := range related {
+			if !walk(&Node{Object: &related[i], Parent: n, Relation: name, Depth: n.Depth + 1}, yield) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// cycle returns true if the node's object is one of its ancestors.
+func (n *Node) cycle() bool {
+	if n.Object.ID == "" {
+		return false
+	}
+	for p := n.Parent; p != nil; p = p.Parent {
+		if p.Object.ID == n.Object.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// Path returns the relations from the top level of the query to the node,
+// e.g. [owners owners].
+func (n *Node) Path() []string {
+	path := make([]string, n.Depth)
+	for p := n; p.Parent != nil; p = p.Parent {
+		path[p.Depth-1] = p.Relation
+	}
+	return path
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+func related(objs ...queryv1alpha2.QueryResponseObject) queryv1alpha2.QueryResponseRelation {
+	return queryv1alpha2.QueryResponseRelation{QueryResponseObjects: queryv1alpha2.QueryResponseObjects{Objects: objs}}
+}
+
+func withRelations(id string, rel map[string]queryv1alpha2.QueryResponseRelation) queryv1alpha2.QueryResponseObject {
+	return queryv1alpha2.QueryResponseObject{ID: id, Relations: rel}
+}
+
+func TestWalk(t *testing.T) {
+	objs := []queryv1alpha2.QueryResponseObject{
+		withRelations("xr", map[string]queryv1alpha2.QueryResponseRelation{
+			"resources": related(
+				withRelations("mr", map[string]queryv1alpha2.QueryResponseRelation{
+					"owners": related(withRelations("xr", map[string]queryv1alpha2.QueryResponseRelation{
+						"resources": related(object("mr")),
+					})),
+				}),
+			),
+			"events": related(object("ev")),
+		}),
+		object("other"),
+	}
+
+	cases := map[string]struct {
+		reason string
+		limit  int
+		want   []string
+	}{
+		"All": {
+			reason: "Every object should be visited depth first, with relations in name order and cycles cut.",
+			want:   []string{"xr:", "ev:events", "mr:resources", "xr:resources/owners", "other:"},
+		},
+		"StopEarly": {
+			reason: "Iteration should stop when the caller stops.",
+			limit:  2,
+			want:   []string{"xr:", "ev:events"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			for n := range Walk(objs) {
+				got = append(got, n.Object.ID+":"+strings.Join(n.Path(), "/"))
+				if len(got) == tc.limit {
+					break
+				}
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nWalk(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
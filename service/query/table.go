@@ -0,0 +1,129 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+const (
+	errWriteCSV        = "cannot write CSV"
+	errWriteJSONLines  = "cannot write JSON lines"
+	errFmtCellCount    = "row %d has %d cells but the table has %d columns"
+	errFmtEncodeCell   = "cannot encode cell in column %q"
+	errFmtDuplicateCol = "table has more than one column named %q"
+)
+
+// A Record is a table row keyed by column name.
+type Record map[string]any
+
+// Records returns the rows of the table as records. Tables returned by a
+// query grouped ByGVKsAndColumn share a kind and columns, so their records do
+// too.
+func Records(t queryv1alpha2.QueryResponseTable) ([]Record, error) {
+	if err := checkTable(t); err != nil {
+		return nil, err
+	}
+	records := make([]Record, 0, len(t.Rows))
+	for _, row := range t.Rows {
+		r := make(Record, len(t.Columns))
+		for i, c := range t.Columns {
+			r[c.Name] = row.Cells[i]
+		}
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+// WriteCSV writes the table as CSV, with a header of column names followed by
+// a line per row. Cells that are not strings or numbers are written as JSON.
+func WriteCSV(w io.Writer, t queryv1alpha2.QueryResponseTable) error {
+	if err := checkTable(t); err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	header := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		header[i] = c.Name
+	}
+	if err := cw.Write(header); err != nil {
+		return errors.Wrap(err, errWriteCSV)
+	}
+	for _, row := range t.Rows {
+		line := make([]string, len(row.Cells))
+		for i, cell := range row.Cells {
+			s, err := formatCell(cell)
+			if err != nil {
+				return errors.Wrapf(err, errFmtEncodeCell, t.Columns[i].Name)
+			}
+			line[i] = s
+		}
+		if err := cw.Write(line); err != nil {
+			return errors.Wrap(err, errWriteCSV)
+		}
+	}
+	cw.Flush()
+	return errors.Wrap(cw.Error(), errWriteCSV)
+}
+
+// WriteJSONLines writes each item as JSON on its own line, e.g. the records
+// of a table or the objects returned by a query.
+func WriteJSONLines[T any](w io.Writer, items []T) error {
+	e := json.NewEncoder(w)
+	for _, i := range items {
+		if err := e.Encode(i); err != nil {
+			return errors.Wrap(err, errWriteJSONLines)
+		}
+	}
+	return nil
+}
+
+// checkTable returns an error if a row of the table does not have a cell for
+// every column, or two columns share a name.
+func checkTable(t queryv1alpha2.QueryResponseTable) error {
+	seen := make(map[string]bool, len(t.Columns))
+	for _, c := range t.Columns {
+		if seen[c.Name] {
+			return errors.Errorf(errFmtDuplicateCol, c.Name)
+		}
+		seen[c.Name] = true
+	}
+	for i, row := range t.Rows {
+		if len(row.Cells) != len(t.Columns) {
+			return errors.Errorf(errFmtCellCount, i, len(row.Cells), len(t.Columns))
+		}
+	}
+	return nil
+}
+
+func formatCell(cell any) (string, error) {
+	switch v := cell.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case bool, int, int32, int64, float32, float64, json.Number:
+		return fmt.Sprint(v), nil
+	}
+	b, err := json.Marshal(cell)
+	return string(b), err
+}
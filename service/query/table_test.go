@@ -0,0 +1,99 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+func table(rows ...[]any) queryv1alpha2.QueryResponseTable {
+	t := queryv1alpha2.QueryResponseTable{
+		GroupVersionKind: metav1.GroupVersionKind{Group: "apiextensions.crossplane.io", Version: "v1", Kind: "Composition"},
+		Columns:          []metav1.TableColumnDefinition{{Name: "Name"}, {Name: "Ready"}, {Name: "Age"}, {Name: "Labels"}},
+	}
+	for _, r := range rows {
+		t.Rows = append(t.Rows, metav1.TableRow{Cells: r})
+	}
+	return t
+}
+
+func TestRecords(t *testing.T) {
+	type want struct {
+		records []Record
+		csv     string
+		err     error
+	}
+	cases := map[string]struct {
+		reason string
+		t      queryv1alpha2.QueryResponseTable
+		want   want
+	}{
+		"Rows": {
+			reason: "Every row should be keyed by column name, and written as a CSV line.",
+			t:      table([]any{"a", true, int64(3), map[string]any{"x": "y"}}, []any{"b, c", false, nil, nil}),
+			want: want{
+				records: []Record{
+					{"Name": "a", "Ready": true, "Age": int64(3), "Labels": map[string]any{"x": "y"}},
+					{"Name": "b, c", "Ready": false, "Age": nil, "Labels": nil},
+				},
+				csv: "Name,Ready,Age,Labels\na,true,3,\"{\"\"x\"\":\"\"y\"\"}\"\n\"b, c\",false,,\n",
+			},
+		},
+		"MissingCells": {
+			reason: "A row without a cell for every column should return an error.",
+			t:      table([]any{"a"}),
+			want:   want{err: errors.Errorf(errFmtCellCount, 0, 1, 4)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Records(tc.t)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nRecords(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.records, got); diff != "" {
+				t.Errorf("\n%s\nRecords(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			buf := &bytes.Buffer{}
+			err = WriteCSV(buf, tc.t)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nWriteCSV(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err == nil {
+				if diff := cmp.Diff(tc.want.csv, buf.String()); diff != "" {
+					t.Errorf("\n%s\nWriteCSV(...): -want, +got:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestWriteJSONLines(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if err := WriteJSONLines(buf, []Record{{"Name": "a"}, {"Name": "b"}}); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("{\"Name\":\"a\"}\n{\"Name\":\"b\"}\n", buf.String()); diff != "" {
+		t.Errorf("\nWriteJSONLines(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+// A Scope selects the control planes a query runs against.
+type Scope struct {
+	// Group is the group of control planes to query. Every control plane in
+	// the Space is queried if it is empty.
+	Group string
+
+	// ControlPlane is the name of the control plane to query within Group.
+	// Every control plane in Group is queried if it is empty.
+	ControlPlane string
+}
+
+// Result is the result of a query.
+type Result struct {
+	// Objects are the objects returned by the query. They are mutually
+	// exclusive with Tables.
+	Objects []queryv1alpha2.QueryResponseObject
+
+	// Tables are the objects returned by the query in table format, grouped
+	// as specified by the query.
+	Tables []queryv1alpha2.QueryResponseTable
+
+	// Count is the number of matching objects remaining after paging. It is
+	// only set if the query asked for it.
+	Count *int
+
+	// Cursor points to the next page of results. It is nil if the query did
+	// not ask for a cursor.
+	Cursor *queryv1alpha2.QueryResponseCursor
+
+	// Warnings are problems the server encountered while running the query.
+	// The query still ran, but its results may not be what was intended.
+	Warnings []string
+
+	// Incomplete is true if the query may have been limited before every
+	// matching object was returned.
+	Incomplete bool
+}
+
+// Next returns the cursor of the next page of results, or an empty string if
+// there are no more pages.
+func (r *Result) Next() string {
+	if r.Cursor == nil {
+		return ""
+	}
+	return r.Cursor.Next
+}
+
+func newResult(res *queryv1alpha2.QueryResponse) *Result {
+	if res == nil {
+		return &Result{}
+	}
+	return &Result{
+		Objects:    res.Objects,
+		Tables:     res.Tables,
+		Count:      res.Count,
+		Cursor:     res.Cursor,
+		Warnings:   res.Warnings,
+		Incomplete: res.Incomplete,
+	}
+}
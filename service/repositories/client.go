@@ -16,6 +16,7 @@ package repositories
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"path"
 
@@ -123,6 +124,18 @@ func (c *Client) List(ctx context.Context, account string, opts ...common.ListOp
 	return r, nil
 }
 
+// ListAll returns an iterator over every repository in the given account on
+// Upbound, fetching pages as it advances.
+func (c *Client) ListAll(ctx context.Context, account string, opts ...common.ListOption) iter.Seq2[Repository, error] {
+	return common.NewPager(func(ctx context.Context, opts ...common.ListOption) ([]Repository, int, error) {
+		res, err := c.List(ctx, account, opts...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.Repositories, res.Size, nil
+	}, opts...).All(ctx)
+}
+
 // Delete a repository on Upbound.
 func (c *Client) Delete(ctx context.Context, account, name string) error { // nolint:interfacer
 	req, err := c.Client.NewRequest(ctx, http.MethodDelete, basePath, path.Join(account, name), nil)
@@ -100,3 +100,17 @@ type RepositoryCreateOrUpdateRequest struct {
 	Public  bool `json:"public"`
 	Publish bool `json:"publish"`
 }
+
+// PackageListResponse is the HTTP body returned when listing the package
+// versions of a repository.
+type PackageListResponse struct {
+	Versions []Package `json:"versions"`
+	Size     int       `json:"size"`
+	Page     int       `json:"page"`
+	Count    int       `json:"count"`
+}
+
+// PackageYankRequest is the HTTP body for yanking a package version.
+type PackageYankRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
@@ -0,0 +1,201 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/upbound/up-sdk-go"
+	uerrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/service/common"
+)
+
+const (
+	versionsPath = "versions"
+	yankPath     = "yank"
+
+	// DefaultPollInterval is how often WaitForPackageStatus fetches the
+	// package version by default.
+	DefaultPollInterval = 5 * time.Second
+
+	errWaitPackage = "cannot wait for package version"
+)
+
+// ErrPackageRejected is returned by WaitForPackageStatus when a package
+// version fails analysis. The error message includes the reason if the API
+// supplied one.
+var ErrPackageRejected = errors.New("package version was rejected")
+
+// VersionsClient is a client for the package versions of a repository.
+type VersionsClient struct {
+	*up.Config
+}
+
+// NewVersionsClient builds a package versions client from the passed config.
+func NewVersionsClient(cfg *up.Config) *VersionsClient {
+	return &VersionsClient{
+		cfg,
+	}
+}
+
+// Versions returns a client for the package versions of repositories.
+func (c *Client) Versions() *VersionsClient {
+	return NewVersionsClient(c.Config)
+}
+
+// List the package versions in a repository on Upbound.
+func (c *VersionsClient) List(ctx context.Context, account, repository string, opts ...common.ListOption) (*PackageListResponse, error) {
+	req, err := c.Client.NewRequest(ctx, http.MethodGet, basePath, path.Join(account, repository, versionsPath), nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range opts {
+		o(req)
+	}
+	r := &PackageListResponse{}
+	if err := c.Client.Do(req, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAll returns an iterator over every package version in a repository on
+// Upbound, fetching pages as it advances.
+func (c *VersionsClient) ListAll(ctx context.Context, account, repository string, opts ...common.ListOption) iter.Seq2[Package, error] {
+	return common.NewPager(func(ctx context.Context, opts ...common.ListOption) ([]Package, int, error) {
+		res, err := c.List(ctx, account, repository, opts...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.Versions, res.Size, nil
+	}, opts...).All(ctx)
+}
+
+// Get a package version in a repository on Upbound. The version may be
+// referenced by its tag, e.g. v1.0.0, or its digest, e.g. sha256:abc.
+func (c *VersionsClient) Get(ctx context.Context, account, repository, ref string) (*Package, error) {
+	req, err := c.Client.NewRequest(ctx, http.MethodGet, basePath, path.Join(account, repository, versionsPath, ref), nil)
+	if err != nil {
+		return nil, err
+	}
+	p := &Package{}
+	if err := c.Client.Do(req, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Delete a package version from a repository on Upbound. The version may be
+// referenced by its tag or digest.
+func (c *VersionsClient) Delete(ctx context.Context, account, repository, ref string) error {
+	req, err := c.Client.NewRequest(ctx, http.MethodDelete, basePath, path.Join(account, repository, versionsPath, ref), nil)
+	if err != nil {
+		return err
+	}
+	return c.Client.Do(req, nil)
+}
+
+// Yank a package version in a repository on Upbound. A yanked version is no
+// longer offered as a dependency but remains available to anyone who pinned
+// it. The version may be referenced by its tag or digest.
+func (c *VersionsClient) Yank(ctx context.Context, account, repository, ref, reason string) error {
+	req, err := c.Client.NewRequest(ctx, http.MethodPost, basePath, path.Join(account, repository, versionsPath, ref, yankPath), &PackageYankRequest{Reason: reason})
+	if err != nil {
+		return err
+	}
+	return c.Client.Do(req, nil)
+}
+
+// A WaitOption modifies how WaitForPackageStatus polls.
+type WaitOption func(*waitOptions)
+
+type waitOptions struct {
+	interval time.Duration
+	target   PackageStatusType
+}
+
+// WithPollInterval sets how often the package version is fetched.
+// Non-positive intervals are ignored.
+func WithPollInterval(d time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		if d > 0 {
+			o.interval = d
+		}
+	}
+}
+
+// WithTargetStatus sets the status WaitForPackageStatus waits for. Versions
+// pushed to a repository that does not publish stop at accepted, so wait for
+// PackageStatusAccepted there. A published version is also accepted.
+func WithTargetStatus(s PackageStatusType) WaitOption {
+	return func(o *waitOptions) {
+		o.target = s
+	}
+}
+
+// WaitForPackageStatus polls a package version until analysis finishes, i.e.
+// until it reaches the target status or is rejected, and returns it. The
+// target status is published unless set with WithTargetStatus. It returns the
+// package and an error wrapping ErrPackageRejected with the reason if the
+// version was rejected. The version is polled until it exists, so it is safe
+// to call right after pushing. If ctx is done first the last observed version
+// is returned with the error, or nil if it was never found.
+func (c *VersionsClient) WaitForPackageStatus(ctx context.Context, account, repository, ref string, opts ...WaitOption) (*Package, error) {
+	o := &waitOptions{interval: DefaultPollInterval, target: PackageStatusPublished}
+	for _, fn := range opts {
+		fn(o)
+	}
+	t := time.NewTicker(o.interval)
+	defer t.Stop()
+	var last *Package
+	for {
+		p, err := c.Get(ctx, account, repository, ref)
+		switch {
+		case err != nil && ctx.Err() != nil:
+			return last, errors.Wrap(ctx.Err(), errWaitPackage)
+		case err != nil && !uerrors.IsNotFound(err):
+			return last, errors.Wrap(err, errWaitPackage)
+		case err != nil:
+			// The version has not been received yet.
+		case p.Status == PackageStatusRejected:
+			if p.Reason != nil && *p.Reason != "" {
+				return p, fmt.Errorf("%w: %s", ErrPackageRejected, *p.Reason)
+			}
+			return p, ErrPackageRejected
+		case reached(p.Status, o.target):
+			return p, nil
+		default:
+			last = p
+		}
+		select {
+		case <-ctx.Done():
+			return last, errors.Wrap(ctx.Err(), errWaitPackage)
+		case <-t.C:
+		}
+	}
+}
+
+// reached returns true if a package version with the status has reached the
+// target status. A published version has also been accepted.
+func reached(status, target PackageStatusType) bool {
+	return status == target || (target == PackageStatusAccepted && status == PackageStatusPublished)
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/upbound/up-sdk-go"
+	uerrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/fake"
+)
+
+func TestVersionsEndpoints(t *testing.T) {
+	type want struct {
+		method string
+		path   string
+		body   interface{}
+	}
+	cases := map[string]struct {
+		reason string
+		call   func(c *VersionsClient) error
+		want   want
+	}{
+		"List": {
+			reason: "Listing versions should get the versions of the repository.",
+			call: func(c *VersionsClient) error {
+				_, err := c.List(context.Background(), "acme", "getting-started")
+				return err
+			},
+			want: want{method: http.MethodGet, path: "acme/getting-started/versions"},
+		},
+		"GetByDigest": {
+			reason: "Getting a version should accept a digest.",
+			call: func(c *VersionsClient) error {
+				_, err := c.Get(context.Background(), "acme", "getting-started", "sha256:abc")
+				return err
+			},
+			want: want{method: http.MethodGet, path: "acme/getting-started/versions/sha256:abc"},
+		},
+		"Delete": {
+			reason: "Deleting a version should delete it by tag.",
+			call: func(c *VersionsClient) error {
+				return c.Delete(context.Background(), "acme", "getting-started", "v1.0.0")
+			},
+			want: want{method: http.MethodDelete, path: "acme/getting-started/versions/v1.0.0"},
+		},
+		"Yank": {
+			reason: "Yanking a version should post the reason.",
+			call: func(c *VersionsClient) error {
+				return c.Yank(context.Background(), "acme", "getting-started", "v1.0.0", "broken")
+			},
+			want: want{method: http.MethodPost, path: "acme/getting-started/versions/v1.0.0/yank", body: &PackageYankRequest{Reason: "broken"}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got want
+			c := NewClient(&up.Config{
+				Client: &fake.MockClient{
+					MockNewRequest: func(_ context.Context, method, prefix, urlPath string, body interface{}) (*http.Request, error) {
+						if prefix != basePath {
+							t.Errorf("unexpected prefix: %s", prefix)
+						}
+						got = want{method: method, path: urlPath, body: body}
+						return nil, nil
+					},
+					MockDo: fake.NewMockDoFn(nil),
+				},
+			}).Versions()
+			if err := tc.call(c); err != nil {
+				t.Errorf("\n%s\nunexpected error:\n%v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\n-want request, +got request:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWaitForPackageStatus(t *testing.T) {
+	errBoom := errors.New("boom")
+	reason := "crd is invalid"
+
+	type step struct {
+		status PackageStatusType
+		reason *string
+		err    error
+	}
+	type want struct {
+		status PackageStatusType
+		gets   int
+		err    error
+	}
+	cases := map[string]struct {
+		reason string
+		opts   []WaitOption
+		steps  []step
+		cancel bool
+		want   want
+	}{
+		"Published": {
+			reason: "Polling should continue through missing and analyzing versions until it is published.",
+			steps: []step{
+				{err: &uerrors.Error{Status: http.StatusNotFound}},
+				{status: PackageStatusAnalyzing},
+				{status: PackageStatusPublished},
+			},
+			want: want{status: PackageStatusPublished, gets: 3},
+		},
+		"Rejected": {
+			reason: "A rejected version should return an error with its reason.",
+			steps:  []step{{status: PackageStatusReceived}, {status: PackageStatusRejected, reason: &reason}},
+			want:   want{status: PackageStatusRejected, gets: 2, err: fmt.Errorf("%w: %s", ErrPackageRejected, reason)},
+		},
+		"Accepted": {
+			reason: "Polling should stop once the version reaches the target status.",
+			opts:   []WaitOption{WithTargetStatus(PackageStatusAccepted)},
+			steps:  []step{{status: PackageStatusAnalyzing}, {status: PackageStatusAccepted}},
+			want:   want{status: PackageStatusAccepted, gets: 2},
+		},
+		"PublishedIsAccepted": {
+			reason: "A published version should satisfy an accepted target status.",
+			opts:   []WaitOption{WithTargetStatus(PackageStatusAccepted)},
+			steps:  []step{{status: PackageStatusPublished}},
+			want:   want{status: PackageStatusPublished, gets: 1},
+		},
+		"ContextDone": {
+			reason: "The last observed version should be returned if the context is done first.",
+			steps:  []step{{status: PackageStatusAnalyzing}, {status: PackageStatusAccepted}},
+			cancel: true,
+			want:   want{status: PackageStatusAccepted, gets: 2, err: errors.Wrap(context.Canceled, errWaitPackage)},
+		},
+		"GetFailed": {
+			reason: "An error other than not found should stop polling.",
+			steps:  []step{{err: errBoom}},
+			want:   want{gets: 1, err: errors.Wrap(errBoom, errWaitPackage)},
+		},
+		"NonPositiveInterval": {
+			reason: "Non-positive poll intervals should be ignored rather than panic.",
+			opts:   []WaitOption{WithPollInterval(-time.Second), WithPollInterval(0)},
+			steps:  []step{{status: PackageStatusAnalyzing}, {status: PackageStatusPublished}},
+			want:   want{status: PackageStatusPublished, gets: 2},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			gets := 0
+			c := NewVersionsClient(&up.Config{
+				Client: &fake.MockClient{
+					MockNewRequest: fake.NewMockNewRequestFn(nil, nil),
+					MockDo: func(_ *http.Request, obj interface{}) error {
+						if gets == len(tc.steps) {
+							return ctx.Err()
+						}
+						s := tc.steps[gets]
+						gets++
+						if tc.cancel && gets == len(tc.steps) {
+							cancel()
+						}
+						if s.err != nil {
+							return s.err
+						}
+						*obj.(*Package) = Package{Status: s.status, Reason: s.reason}
+						return nil
+					},
+				},
+			})
+			p, err := c.WaitForPackageStatus(ctx, "acme", "getting-started", "v1.0.0", append([]WaitOption{WithPollInterval(time.Millisecond)}, tc.opts...)...)
+			got := want{gets: gets, err: err}
+			if p != nil {
+				got.status = p.Status
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nWaitForPackageStatus(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if tc.want.status == PackageStatusRejected && !errors.Is(err, ErrPackageRejected) {
+				t.Errorf("\n%s\nWaitForPackageStatus(...): want error to wrap ErrPackageRejected", tc.reason)
+			}
+		})
+	}
+}
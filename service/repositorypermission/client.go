@@ -16,16 +16,19 @@ package repositorypermission
 
 import (
 	"context"
-	"fmt"
+	"iter"
 	"net/http"
+	"path"
 
 	"github.com/google/uuid"
 
 	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/common"
 )
 
 const (
-	basePath = "v1/repoPermissions/%s/teams/%s"
+	basePath  = "v1/repoPermissions"
+	teamsPath = "teams"
 )
 
 // Client is a repositories permission client.
@@ -42,7 +45,7 @@ func NewClient(cfg *up.Config) *Client {
 
 // Create assigns a specified permission to a team for a repository on Upbound.
 func (c *Client) Create(ctx context.Context, organization string, teamID uuid.UUID, params CreatePermission) error {
-	req, err := c.Client.NewRequest(ctx, http.MethodPut, fmt.Sprintf(basePath, organization, teamID), params.Repository, params.Permission)
+	req, err := c.Client.NewRequest(ctx, http.MethodPut, basePath, path.Join(organization, teamsPath, teamID.String(), params.Repository), params.Permission)
 	if err != nil {
 		return err
 	}
@@ -51,22 +54,38 @@ func (c *Client) Create(ctx context.Context, organization string, teamID uuid.UU
 
 // Delete removes a specified permission from a team for a repository on Upbound.
 func (c *Client) Delete(ctx context.Context, organization string, teamID uuid.UUID, params PermissionIdentifier) error { // nolint:interfacer
-	req, err := c.Client.NewRequest(ctx, http.MethodDelete, fmt.Sprintf(basePath, organization, teamID), params.Repository, nil)
+	req, err := c.Client.NewRequest(ctx, http.MethodDelete, basePath, path.Join(organization, teamsPath, teamID.String(), params.Repository), nil)
 	if err != nil {
 		return err
 	}
 	return c.Client.Do(req, nil)
 }
 
-// List retrieves all repository permissions assigned to a team on Upbound.
-func (c *Client) List(ctx context.Context, organization string, teamID uuid.UUID) (*ListPermissionsResponse, error) {
-	req, err := c.Client.NewRequest(ctx, http.MethodGet, fmt.Sprintf(basePath, organization, teamID), "", nil)
+// List retrieves a page of repository permissions assigned to a team on
+// Upbound. Use ListAll to retrieve every page.
+func (c *Client) List(ctx context.Context, organization string, teamID uuid.UUID, opts ...common.ListOption) (*ListPermissionsResponse, error) {
+	req, err := c.Client.NewRequest(ctx, http.MethodGet, basePath, path.Join(organization, teamsPath, teamID.String()), nil)
 	if err != nil {
 		return nil, err
 	}
+	for _, o := range opts {
+		o(req)
+	}
 	r := &ListPermissionsResponse{}
 	if err := c.Client.Do(req, r); err != nil {
 		return nil, err
 	}
 	return r, nil
 }
+
+// ListAll returns an iterator over every repository permission assigned to a
+// team on Upbound, fetching pages as it advances.
+func (c *Client) ListAll(ctx context.Context, organization string, teamID uuid.UUID, opts ...common.ListOption) iter.Seq2[Permission, error] {
+	return common.NewPager(func(ctx context.Context, opts ...common.ListOption) ([]Permission, int, error) {
+		res, err := c.List(ctx, organization, teamID, opts...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.Permissions, res.Size, nil
+	}, opts...).All(ctx)
+}
@@ -0,0 +1,230 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositorypermission
+
+import (
+	"cmp"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/upbound/up-sdk-go/service/common"
+	"github.com/upbound/up-sdk-go/service/organizations"
+	"github.com/upbound/up-sdk-go/service/teams"
+)
+
+const (
+	// DefaultMatrixConcurrency is how many teams are read at once by default.
+	DefaultMatrixConcurrency = 8
+
+	errGetOrgID      = "cannot get organization ID"
+	errListTeams     = "cannot list teams"
+	errListTeamPerms = "cannot list repository permissions of team %q"
+	errListMembers   = "cannot list members of team %q"
+	errWriteCSV      = "cannot write CSV"
+)
+
+// rank orders permissions from least to most privileged.
+var rank = map[PermissionType]int{ //nolint:gochecknoglobals // This is intended to be global.
+	PermissionView:  1,
+	PermissionRead:  2,
+	PermissionWrite: 3,
+	PermissionAdmin: 4,
+}
+
+// A TeamPermission is a team's permission on a repository.
+type TeamPermission struct {
+	TeamID     uuid.UUID      `json:"teamId"`
+	TeamName   string         `json:"teamName"`
+	Permission PermissionType `json:"permission"`
+}
+
+// A Matrix is the organization-wide view of repository permissions: which
+// teams may access each repository.
+type Matrix struct {
+	Organization string `json:"organization"`
+
+	// Repositories maps the name of each repository to the teams that may
+	// access it, ordered by team name.
+	Repositories map[string][]TeamPermission `json:"repositories"`
+
+	// Members maps the username of each team member to the IDs of their
+	// teams.
+	Members map[string][]uuid.UUID `json:"members"`
+}
+
+// A MatrixOption modifies how a Matrix is built.
+type MatrixOption func(*matrixOptions)
+
+type matrixOptions struct {
+	concurrency int
+	orgIDs      *common.Resolver[uint]
+}
+
+// WithConcurrency sets how many teams are read at once. Values less than one
+// use DefaultMatrixConcurrency.
+func WithConcurrency(n int) MatrixOption {
+	return func(o *matrixOptions) {
+		if n <= 0 {
+			n = DefaultMatrixConcurrency
+		}
+		o.concurrency = n
+	}
+}
+
+// WithOrgIDResolver sets the Resolver used to resolve the organization name to
+// its ID, so that it shares a cache with other clients. See
+// organizations.NewOrgIDResolver.
+func WithOrgIDResolver(r *common.Resolver[uint]) MatrixOption {
+	return func(o *matrixOptions) {
+		o.orgIDs = r
+	}
+}
+
+// Matrix builds the repository permission matrix of the organization by
+// reading the permissions and members of every team concurrently. Teams are
+// walked with teams.Client.ListAll rather than organizations.ListTeams, which
+// is deprecated and returns a single unpaged list that is truncated for large
+// organizations.
+func (c *Client) Matrix(ctx context.Context, organization string, opts ...MatrixOption) (*Matrix, error) {
+	o := &matrixOptions{concurrency: DefaultMatrixConcurrency}
+	for _, fn := range opts {
+		fn(o)
+	}
+
+	var oo []organizations.ClientOption
+	if o.orgIDs != nil {
+		oo = append(oo, organizations.WithOrgIDResolver(o.orgIDs))
+	}
+	orgID, err := organizations.NewClient(c.Config, oo...).GetOrgID(ctx, organization)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetOrgID)
+	}
+	tc := teams.NewClient(c.Config)
+
+	m := &Matrix{
+		Organization: organization,
+		Repositories: map[string][]TeamPermission{},
+		Members:      map[string][]uuid.UUID{},
+	}
+	var mu sync.Mutex
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(o.concurrency)
+	for t, err := range tc.ListAll(gctx, orgID) {
+		if err != nil {
+			// Listing fails with a cancelled context if a team could not be
+			// read, in which case that is the error worth returning.
+			if werr := g.Wait(); werr != nil {
+				return nil, werr
+			}
+			return nil, errors.Wrap(err, errListTeams)
+		}
+		g.Go(func() error {
+			var perms []Permission
+			for p, err := range c.ListAll(gctx, organization, t.ID) {
+				if err != nil {
+					return errors.Wrapf(err, errListTeamPerms, t.Name)
+				}
+				perms = append(perms, p)
+			}
+			members, err := tc.ListMembers(gctx, t.ID)
+			if err != nil {
+				return errors.Wrapf(err, errListMembers, t.Name)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, p := range perms {
+				m.Repositories[p.RepositoryName] = append(m.Repositories[p.RepositoryName], TeamPermission{TeamID: t.ID, TeamName: t.Name, Permission: p.Privilege})
+			}
+			for _, u := range members {
+				m.Members[u.Username] = append(m.Members[u.Username], t.ID)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	for _, tps := range m.Repositories {
+		slices.SortFunc(tps, func(a, b TeamPermission) int {
+			return cmp.Or(cmp.Compare(a.TeamName, b.TeamName), cmp.Compare(a.TeamID.String(), b.TeamID.String()))
+		})
+	}
+	for _, ids := range m.Members {
+		slices.SortFunc(ids, func(a, b uuid.UUID) int {
+			return cmp.Compare(a.String(), b.String())
+		})
+	}
+	return m, nil
+}
+
+// Teams returns the teams that hold the supplied permission on the
+// repository.
+func (m *Matrix) Teams(repository string, p PermissionType) []TeamPermission {
+	var out []TeamPermission
+	for _, tp := range m.Repositories[repository] {
+		if tp.Permission == p {
+			out = append(out, tp)
+		}
+	}
+	return out
+}
+
+// EffectivePermission returns the most privileged permission the user holds
+// on the repository through any of their teams. It returns false if none of
+// the user's teams may access the repository.
+func (m *Matrix) EffectivePermission(username, repository string) (PermissionType, bool) {
+	var best PermissionType
+	for _, tp := range m.Repositories[repository] {
+		if slices.Contains(m.Members[username], tp.TeamID) && rank[tp.Permission] > rank[best] {
+			best = tp.Permission
+		}
+	}
+	return best, best != ""
+}
+
+// WriteJSON writes the matrix as JSON, including the team members, so that
+// a decoded matrix can still report effective permissions.
+func (m *Matrix) WriteJSON(w io.Writer) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(m)
+}
+
+// WriteCSV writes the matrix as CSV with one row per repository and team,
+// ordered by repository.
+func (m *Matrix) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"repository", "team", "teamId", "permission"}); err != nil {
+		return errors.Wrap(err, errWriteCSV)
+	}
+	for _, repo := range slices.Sorted(maps.Keys(m.Repositories)) {
+		for _, tp := range m.Repositories[repo] {
+			if err := cw.Write([]string{repo, tp.TeamName, tp.TeamID.String(), string(tp.Permission)}); err != nil {
+				return errors.Wrap(err, errWriteCSV)
+			}
+		}
+	}
+	cw.Flush()
+	return errors.Wrap(cw.Error(), errWriteCSV)
+}
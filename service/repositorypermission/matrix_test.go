@@ -0,0 +1,200 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositorypermission
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/fake"
+	"github.com/upbound/up-sdk-go/service/organizations"
+	"github.com/upbound/up-sdk-go/service/teams"
+)
+
+var (
+	devsID = uuid.MustParse("4654b8b5-c01d-4fbe-8800-22c347c21383")
+	opsID  = uuid.MustParse("b1a5c1c5-3a5e-4b8a-9b3a-6d0f1b6d2f11")
+)
+
+// fakeOrg serves an organization with a devs and an ops team.
+func fakeOrg(t *testing.T) *up.Config {
+	t.Helper()
+	return &up.Config{
+		Client: &fake.MockClient{
+			MockNewRequest: func(_ context.Context, method, prefix, urlPath string, _ interface{}) (*http.Request, error) {
+				return httptest.NewRequest(method, "/"+path.Join(prefix, urlPath), nil), nil
+			},
+			MockDo: func(req *http.Request, obj interface{}) error {
+				switch req.URL.Path {
+				case "/v1/organizations":
+					*obj.(*[]organizations.Organization) = []organizations.Organization{{ID: 1, Name: "acme"}}
+				case "/v1/teams":
+					*obj.(*teams.TeamListResponse) = teams.TeamListResponse{Size: 10, Teams: []teams.TeamResponse{
+						{ID: devsID, Name: "devs"},
+						{ID: opsID, Name: "ops"},
+					}}
+				case fmt.Sprintf("/v1/teams/%s/members", devsID):
+					*obj.(*[]teams.TeamMember) = []teams.TeamMember{{Username: "alice"}, {Username: "bob"}}
+				case fmt.Sprintf("/v1/teams/%s/members", opsID):
+					*obj.(*[]teams.TeamMember) = []teams.TeamMember{{Username: "bob"}}
+				case fmt.Sprintf("/v1/repoPermissions/acme/teams/%s", devsID):
+					*obj.(*ListPermissionsResponse) = ListPermissionsResponse{Size: 10, Permissions: []Permission{
+						{RepositoryName: "configs", Privilege: PermissionRead},
+						{RepositoryName: "functions", Privilege: PermissionWrite},
+					}}
+				case fmt.Sprintf("/v1/repoPermissions/acme/teams/%s", opsID):
+					*obj.(*ListPermissionsResponse) = ListPermissionsResponse{Size: 10, Permissions: []Permission{
+						{RepositoryName: "configs", Privilege: PermissionAdmin},
+					}}
+				default:
+					t.Errorf("unexpected request: %s", req.URL.Path)
+				}
+				return nil
+			},
+		},
+	}
+}
+
+func TestMatrix(t *testing.T) {
+	m, err := NewClient(fakeOrg(t)).Matrix(context.Background(), "acme", WithConcurrency(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string][]TeamPermission{
+		"configs": {
+			{TeamID: devsID, TeamName: "devs", Permission: PermissionRead},
+			{TeamID: opsID, TeamName: "ops", Permission: PermissionAdmin},
+		},
+		"functions": {
+			{TeamID: devsID, TeamName: "devs", Permission: PermissionWrite},
+		},
+	}
+	if diff := cmp.Diff(want, m.Repositories); diff != "" {
+		t.Errorf("\nMatrix(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]TeamPermission{{TeamID: opsID, TeamName: "ops", Permission: PermissionAdmin}}, m.Teams("configs", PermissionAdmin)); diff != "" {
+		t.Errorf("\nTeams(...): -want, +got:\n%s", diff)
+	}
+
+	b := &bytes.Buffer{}
+	if err := m.WriteJSON(b); err != nil {
+		t.Fatal(err)
+	}
+	decoded := &Matrix{}
+	if err := json.Unmarshal(b.Bytes(), decoded); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(m, decoded); diff != "" {
+		t.Errorf("\nWriteJSON(...): -want, +got:\n%s", diff)
+	}
+
+	csv := &bytes.Buffer{}
+	if err := m.WriteCSV(csv); err != nil {
+		t.Fatal(err)
+	}
+	wantCSV := fmt.Sprintf("repository,team,teamId,permission\nconfigs,devs,%s,read\nconfigs,ops,%s,admin\nfunctions,devs,%s,write\n", devsID, opsID, devsID)
+	if diff := cmp.Diff(wantCSV, csv.String()); diff != "" {
+		t.Errorf("\nWriteCSV(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestWithConcurrency(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		n      int
+		want   int
+	}{
+		"Positive": {
+			reason: "A positive concurrency should be used as is.",
+			n:      2,
+			want:   2,
+		},
+		"Zero": {
+			reason: "A zero concurrency would block forever, so the default should be used.",
+			n:      0,
+			want:   DefaultMatrixConcurrency,
+		},
+		"Negative": {
+			reason: "A negative concurrency would remove the limit, so the default should be used.",
+			n:      -1,
+			want:   DefaultMatrixConcurrency,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := &matrixOptions{}
+			WithConcurrency(tc.n)(o)
+			if diff := cmp.Diff(tc.want, o.concurrency); diff != "" {
+				t.Errorf("\n%s\nWithConcurrency(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEffectivePermission(t *testing.T) {
+	m, err := NewClient(fakeOrg(t)).Matrix(context.Background(), "acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type want struct {
+		p  PermissionType
+		ok bool
+	}
+	cases := map[string]struct {
+		reason string
+		user   string
+		repo   string
+		want   want
+	}{
+		"SingleTeam": {
+			reason: "A user in one team should get that team's permission.",
+			user:   "alice",
+			repo:   "configs",
+			want:   want{p: PermissionRead, ok: true},
+		},
+		"MostPrivileged": {
+			reason: "A user in several teams should get the most privileged permission.",
+			user:   "bob",
+			repo:   "configs",
+			want:   want{p: PermissionAdmin, ok: true},
+		},
+		"NoAccess": {
+			reason: "A user whose teams cannot access the repository should get no permission.",
+			user:   "carol",
+			repo:   "functions",
+			want:   want{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p, ok := m.EffectivePermission(tc.user, tc.repo)
+			if diff := cmp.Diff(tc.want, want{p: p, ok: ok}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nEffectivePermission(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
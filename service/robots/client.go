@@ -16,19 +16,27 @@ package robots
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"path"
+	"strconv"
 
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 
 	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/common"
+	"github.com/upbound/up-sdk-go/service/teams"
 	"github.com/upbound/up-sdk-go/service/tokens"
 )
 
 const (
-	basePath          = "v2/robots"
-	tokensPath        = "tokens"
-	teamsRelationPath = "relationships/teams"
+	basePath   = "v2/robots"
+	tokensPath = "tokens"
+
+	organizationIDParam = "organizationId"
+
+	errParseTeamID = "cannot parse team ID"
 )
 
 // Client is an robots client.
@@ -76,6 +84,66 @@ func (c *Client) Get(ctx context.Context, id uuid.UUID) (*RobotResponse, error)
 	return r, nil
 }
 
+// List the robots in the organization on Upbound.
+func (c *Client) List(ctx context.Context, orgID uint, opts ...common.ListOption) (*RobotsResponse, error) {
+	r, _, err := c.list(ctx, orgID, opts...)
+	return r, err
+}
+
+// ListAll returns an iterator over every robot in the organization on Upbound,
+// fetching pages as it advances. Supply common.WithSize to set the page size.
+func (c *Client) ListAll(ctx context.Context, orgID uint, opts ...common.ListOption) iter.Seq2[common.DataSet, error] {
+	return common.NewPager(func(ctx context.Context, opts ...common.ListOption) ([]common.DataSet, int, error) {
+		res, size, err := c.list(ctx, orgID, opts...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.DataSet, size, nil
+	}, opts...).All(ctx)
+}
+
+// list gets one page of robots. The response does not report the page size,
+// so list returns the size that was requested, or zero if none was, in which
+// case paging stops at the first empty page.
+func (c *Client) list(ctx context.Context, orgID uint, opts ...common.ListOption) (*RobotsResponse, int, error) {
+	req, err := c.Client.NewRequest(ctx, http.MethodGet, basePath, "", nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	q := req.URL.Query()
+	q.Set(organizationIDParam, strconv.FormatUint(uint64(orgID), 10))
+	req.URL.RawQuery = q.Encode()
+	for _, o := range opts {
+		o(req)
+	}
+	size, _ := strconv.Atoi(req.URL.Query().Get(common.SizeParam))
+	r := &RobotsResponse{}
+	if err := c.Client.Do(req, r); err != nil {
+		return nil, 0, err
+	}
+	return r, size, nil
+}
+
+// Update the name and description of a robot on Upbound.
+func (c *Client) Update(ctx context.Context, id uuid.UUID, params *RobotAttributes) (*RobotResponse, error) { // nolint:interfacer
+	body := &robotUpdateRequest{
+		Data: robotUpdateParameters{
+			Type:       robotBody,
+			ID:         id,
+			Attributes: params,
+		},
+	}
+	req, err := c.Client.NewRequest(ctx, http.MethodPatch, basePath, id.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	r := &RobotResponse{}
+	if err := c.Client.Do(req, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
 // ListTokens lists tokens for a robot on Upbound.
 func (c *Client) ListTokens(ctx context.Context, id uuid.UUID) (*tokens.TokensResponse, error) { // nolint:interfacer
 	req, err := c.Client.NewRequest(ctx, http.MethodGet, basePath, path.Join(id.String(), tokensPath), nil)
@@ -99,23 +167,23 @@ func (c *Client) Delete(ctx context.Context, id uuid.UUID) error { // nolint:int
 }
 
 // CreateTeamMembership create a robot team membership on Upbound.
+//
+// Deprecated: Use teams.Client.AddRobot instead.
 func (c *Client) CreateTeamMembership(ctx context.Context, id uuid.UUID, params *RobotTeamMembershipResourceIdentifier) error {
-	req, err := c.Client.NewRequest(ctx, http.MethodPost, basePath, path.Join(id.String(), teamsRelationPath), &RobotTeamMembershipRelationshipList{
-		Data: []RobotTeamMembershipResourceIdentifier{*params},
-	})
+	teamID, err := uuid.Parse(params.ID)
 	if err != nil {
-		return err
+		return errors.Wrap(err, errParseTeamID)
 	}
-	return c.Client.Do(req, nil)
+	return teams.NewClient(c.Config).AddRobot(ctx, teamID, id)
 }
 
 // DeleteTeamMembership delete a robot team membership on Upbound.
+//
+// Deprecated: Use teams.Client.RemoveRobot instead.
 func (c *Client) DeleteTeamMembership(ctx context.Context, id uuid.UUID, params *RobotTeamMembershipResourceIdentifier) error {
-	req, err := c.Client.NewRequest(ctx, http.MethodDelete, basePath, path.Join(id.String(), teamsRelationPath), &RobotTeamMembershipRelationshipList{
-		Data: []RobotTeamMembershipResourceIdentifier{*params},
-	})
+	teamID, err := uuid.Parse(params.ID)
 	if err != nil {
-		return err
+		return errors.Wrap(err, errParseTeamID)
 	}
-	return c.Client.Do(req, nil)
+	return teams.NewClient(c.Config).RemoveRobot(ctx, teamID, id)
 }
@@ -17,6 +17,7 @@ package robots
 import (
 	"context"
 	"net/http"
+	"net/http/httptest"
 	"path"
 	"testing"
 
@@ -27,6 +28,7 @@ import (
 
 	"github.com/upbound/up-sdk-go"
 	"github.com/upbound/up-sdk-go/fake"
+	"github.com/upbound/up-sdk-go/service/common"
 	"github.com/upbound/up-sdk-go/service/tokens"
 )
 
@@ -402,3 +404,208 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestList(t *testing.T) {
+	var query string
+	c := NewClient(&up.Config{
+		Client: &fake.MockClient{
+			MockNewRequest: func(_ context.Context, method, prefix, urlPath string, _ interface{}) (*http.Request, error) {
+				if method != http.MethodGet || prefix != basePath || urlPath != "" {
+					t.Errorf("unexpected request: %s %s/%s", method, prefix, urlPath)
+				}
+				return httptest.NewRequest(method, "/"+prefix, nil), nil
+			},
+			MockDo: func(req *http.Request, _ interface{}) error {
+				query = req.URL.RawQuery
+				return nil
+			},
+		},
+	})
+	if _, err := c.List(context.Background(), 7, common.WithSize(10)); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("organizationId=7&size=10", query); diff != "" {
+		t.Errorf("\nList(...): -want query, +got query:\n%s", diff)
+	}
+}
+
+func TestListAll(t *testing.T) {
+	errBoom := errors.New("boom")
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	pages := [][]common.DataSet{{{ID: ids[0]}, {ID: ids[1]}}, {{ID: ids[2]}}, {}}
+
+	cases := map[string]struct {
+		reason  string
+		opts    []common.ListOption
+		doErr   error
+		want    []uuid.UUID
+		queries []string
+		err     error
+	}{
+		"WithSize": {
+			reason:  "Paging should stop at the first page shorter than the requested size.",
+			opts:    []common.ListOption{common.WithSize(2)},
+			want:    ids,
+			queries: []string{"organizationId=7&page=0&size=2", "organizationId=7&page=1&size=2"},
+		},
+		"WithoutSize": {
+			reason:  "Without a requested size paging should stop at the first empty page.",
+			want:    ids,
+			queries: []string{"organizationId=7&page=0", "organizationId=7&page=1", "organizationId=7&page=2"},
+		},
+		"DoFailed": {
+			reason:  "Failing to fetch a page should return an error.",
+			doErr:   errBoom,
+			queries: []string{"organizationId=7&page=0"},
+			err:     errBoom,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var queries []string
+			c := NewClient(&up.Config{
+				Client: &fake.MockClient{
+					MockNewRequest: func(_ context.Context, method, prefix, _ string, _ interface{}) (*http.Request, error) {
+						return httptest.NewRequest(method, "/"+prefix, nil), nil
+					},
+					MockDo: func(req *http.Request, obj interface{}) error {
+						queries = append(queries, req.URL.RawQuery)
+						if tc.doErr != nil {
+							return tc.doErr
+						}
+						obj.(*RobotsResponse).DataSet = pages[len(queries)-1]
+						return nil
+					},
+				},
+			})
+			var got []uuid.UUID
+			var err error
+			for r, lerr := range c.ListAll(context.Background(), 7, tc.opts...) {
+				if lerr != nil {
+					err = lerr
+					break
+				}
+				got = append(got, r.ID)
+			}
+			if diff := cmp.Diff(tc.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nListAll(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nListAll(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.queries, queries); diff != "" {
+				t.Errorf("\n%s\nListAll(...): -want queries, +got queries:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+	id := uuid.MustParse("4654b8b5-c01d-4fbe-8800-22c347c21383")
+
+	cases := map[string]struct {
+		reason string
+		doErr  error
+		want   *RobotResponse
+		err    error
+	}{
+		"DoFailed": {
+			reason: "Failing to execute request should return an error.",
+			doErr:  errBoom,
+			err:    errBoom,
+		},
+		"Successful": {
+			reason: "A successful request should return the updated robot.",
+			want:   &RobotResponse{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewClient(&up.Config{
+				Client: &fake.MockClient{
+					MockNewRequest: func(_ context.Context, method, prefix, urlPath string, body interface{}) (*http.Request, error) {
+						if method != http.MethodPatch || prefix != basePath || urlPath != id.String() {
+							t.Errorf("unexpected request: %s %s/%s", method, prefix, urlPath)
+						}
+						want := &robotUpdateRequest{Data: robotUpdateParameters{Type: robotBody, ID: id, Attributes: &RobotAttributes{Name: "robot", Description: "desc"}}}
+						if diff := cmp.Diff(want, body); diff != "" {
+							t.Errorf("unexpected body: -want, +got:\n%s", diff)
+						}
+						return nil, nil
+					},
+					MockDo: fake.NewMockDoFn(tc.doErr),
+				},
+			})
+			res, err := c.Update(context.Background(), id, &RobotAttributes{Name: "robot", Description: "desc"})
+			if diff := cmp.Diff(tc.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, res); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTeamMembership(t *testing.T) {
+	id := uuid.MustParse("4654b8b5-c01d-4fbe-8800-22c347c21383")
+	teamID := uuid.MustParse("2be5c8f4-9b5c-4a6a-9dd0-8d1a3e2a6f10")
+
+	type want struct {
+		method string
+		err    bool
+	}
+	cases := map[string]struct {
+		reason string
+		call   func(c *Client, params *RobotTeamMembershipResourceIdentifier) error
+		teamID string
+		want   want
+	}{
+		"Create": {
+			reason: "Creating a membership should add the robot to the team.",
+			call: func(c *Client, params *RobotTeamMembershipResourceIdentifier) error {
+				return c.CreateTeamMembership(context.Background(), id, params) //nolint:staticcheck // Testing the deprecated method.
+			},
+			teamID: teamID.String(),
+			want:   want{method: http.MethodPost},
+		},
+		"Delete": {
+			reason: "Deleting a membership should remove the robot from the team.",
+			call: func(c *Client, params *RobotTeamMembershipResourceIdentifier) error {
+				return c.DeleteTeamMembership(context.Background(), id, params) //nolint:staticcheck // Testing the deprecated method.
+			},
+			teamID: teamID.String(),
+			want:   want{method: http.MethodDelete},
+		},
+		"InvalidTeamID": {
+			reason: "A team ID that is not a UUID should return an error.",
+			call: func(c *Client, params *RobotTeamMembershipResourceIdentifier) error {
+				return c.CreateTeamMembership(context.Background(), id, params) //nolint:staticcheck // Testing the deprecated method.
+			},
+			teamID: "team",
+			want:   want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got want
+			c := NewClient(&up.Config{
+				Client: &fake.MockClient{
+					MockNewRequest: func(_ context.Context, method, prefix, urlPath string, _ interface{}) (*http.Request, error) {
+						if prefix != basePath || urlPath != path.Join(id.String(), "relationships/teams") {
+							t.Errorf("unexpected request: %s %s/%s", method, prefix, urlPath)
+						}
+						got.method = method
+						return nil, nil
+					},
+					MockDo: fake.NewMockDoFn(nil),
+				},
+			})
+			got.err = tc.call(c, &RobotTeamMembershipResourceIdentifier{Type: RobotTeamMembershipTypeTeam, ID: tc.teamID}) != nil
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nTeamMembership(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
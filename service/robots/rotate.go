@@ -0,0 +1,133 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package robots
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/upbound/up-sdk-go/service/tokens"
+)
+
+const (
+	errListTokens   = "cannot list robot tokens"
+	errCreateToken  = "cannot create robot token"
+	errRevokeTokens = "cannot revoke previous robot token %s"
+)
+
+// A RotateTokenOption modifies how a robot token is rotated.
+type RotateTokenOption func(*rotateTokenOptions)
+
+type rotateTokenOptions struct {
+	revoke bool
+	grace  time.Duration
+}
+
+// WithRevokePrevious deletes the tokens the robot had before rotation once the
+// supplied grace period has passed, giving consumers time to switch to the new
+// token. The previous tokens are kept if this option is not supplied.
+func WithRevokePrevious(grace time.Duration) RotateTokenOption {
+	return func(o *rotateTokenOptions) {
+		o.revoke = true
+		o.grace = grace
+	}
+}
+
+// A TokenRotation is the result of rotating a robot token.
+type TokenRotation struct {
+	// Token is the new token. Its value is only available in this response.
+	Token *tokens.TokenResponse
+
+	// Previous are the IDs of the tokens the robot had before rotation.
+	Previous []uuid.UUID
+
+	done chan struct{}
+	err  error
+}
+
+// Wait blocks until the previous tokens have been revoked, returning any error
+// encountered. It returns nil immediately if revocation was not requested.
+func (r *TokenRotation) Wait() error {
+	if r.done == nil {
+		return nil
+	}
+	<-r.done
+	return r.err
+}
+
+// RotateToken creates a new token with the supplied name for a robot on
+// Upbound and returns it. If WithRevokePrevious is supplied the robot's
+// previous tokens are deleted in the background once the grace period has
+// passed; use TokenRotation.Wait to learn whether that succeeded. Revocation
+// is abandoned if ctx is done before it completes.
+func (c *Client) RotateToken(ctx context.Context, id uuid.UUID, name string, opts ...RotateTokenOption) (*TokenRotation, error) { // nolint:interfacer
+	o := &rotateTokenOptions{}
+	for _, fn := range opts {
+		fn(o)
+	}
+
+	existing, err := c.ListTokens(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, errListTokens)
+	}
+	tc := tokens.NewClient(c.Config)
+	t, err := tc.Create(ctx, &tokens.TokenCreateParameters{
+		Attributes: tokens.TokenAttributes{Name: name},
+		Relationships: tokens.TokenRelationships{
+			Owner: tokens.TokenOwner{
+				Data: tokens.TokenOwnerData{
+					Type: tokens.TokenOwnerRobot,
+					ID:   id.String(),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateToken)
+	}
+
+	r := &TokenRotation{Token: t}
+	for _, d := range existing.DataSet {
+		if d.ID != t.ID {
+			r.Previous = append(r.Previous, d.ID)
+		}
+	}
+	if !o.revoke || len(r.Previous) == 0 {
+		return r, nil
+	}
+
+	r.done = make(chan struct{})
+	go func() {
+		defer close(r.done)
+		timer := time.NewTimer(o.grace)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			r.err = ctx.Err()
+			return
+		case <-timer.C:
+		}
+		for _, prev := range r.Previous {
+			if err := tc.Delete(ctx, prev); err != nil {
+				r.err = errors.Wrapf(err, errRevokeTokens, prev)
+				return
+			}
+		}
+	}()
+	return r, nil
+}
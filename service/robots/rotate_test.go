@@ -0,0 +1,134 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package robots
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"sync"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/fake"
+	"github.com/upbound/up-sdk-go/service/common"
+	"github.com/upbound/up-sdk-go/service/tokens"
+)
+
+func TestRotateToken(t *testing.T) {
+	errBoom := errors.New("boom")
+	robot := uuid.MustParse("4654b8b5-c01d-4fbe-8800-22c347c21383")
+	old := uuid.MustParse("b1a5c1c5-3a5e-4b8a-9b3a-6d0f1b6d2f11")
+	created := uuid.MustParse("0d6b2b8e-4b8a-4c39-9d55-8d3f4e1c2a10")
+
+	type want struct {
+		previous []uuid.UUID
+		calls    []string
+		err      error
+		waitErr  error
+	}
+	cases := map[string]struct {
+		reason    string
+		opts      []RotateTokenOption
+		deleteErr error
+		createErr error
+		want      want
+	}{
+		"KeepPrevious": {
+			reason: "Previous tokens should be kept unless revocation is requested.",
+			want: want{
+				previous: []uuid.UUID{old},
+				calls:    []string{"GET v2/robots/" + robot.String() + "/tokens", "POST v1/tokens"},
+			},
+		},
+		"RevokePrevious": {
+			reason: "Previous tokens should be deleted once the grace period has passed.",
+			opts:   []RotateTokenOption{WithRevokePrevious(0)},
+			want: want{
+				previous: []uuid.UUID{old},
+				calls:    []string{"GET v2/robots/" + robot.String() + "/tokens", "POST v1/tokens", "DELETE v1/tokens/" + old.String()},
+			},
+		},
+		"RevokeFailed": {
+			reason:    "Failing to delete a previous token should be reported by Wait.",
+			opts:      []RotateTokenOption{WithRevokePrevious(0)},
+			deleteErr: errBoom,
+			want: want{
+				previous: []uuid.UUID{old},
+				calls:    []string{"GET v2/robots/" + robot.String() + "/tokens", "POST v1/tokens", "DELETE v1/tokens/" + old.String()},
+				waitErr:  errors.Wrapf(errBoom, errRevokeTokens, old),
+			},
+		},
+		"CreateFailed": {
+			reason:    "Failing to create the new token should return an error and revoke nothing.",
+			opts:      []RotateTokenOption{WithRevokePrevious(0)},
+			createErr: errBoom,
+			want: want{
+				calls: []string{"GET v2/robots/" + robot.String() + "/tokens", "POST v1/tokens"},
+				err:   errors.Wrap(errBoom, errCreateToken),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var mu sync.Mutex
+			var got want
+			c := NewClient(&up.Config{
+				Client: &fake.MockClient{
+					MockNewRequest: func(_ context.Context, method, prefix, urlPath string, body interface{}) (*http.Request, error) {
+						mu.Lock()
+						defer mu.Unlock()
+						got.calls = append(got.calls, method+" "+path.Join(prefix, urlPath))
+						return httptest.NewRequest(method, "/"+path.Join(prefix, urlPath), nil), nil
+					},
+					MockDo: func(req *http.Request, obj interface{}) error {
+						switch req.Method {
+						case http.MethodGet:
+							obj.(*tokens.TokensResponse).DataSet = []common.DataSet{{ID: old}}
+						case http.MethodPost:
+							if tc.createErr != nil {
+								return tc.createErr
+							}
+							(*obj.(**tokens.TokenResponse)).ID = created
+						case http.MethodDelete:
+							return tc.deleteErr
+						}
+						return nil
+					},
+				},
+			})
+			r, err := c.RotateToken(context.Background(), robot, "rotated", tc.opts...)
+			got.err = err
+			if r != nil {
+				got.previous = r.Previous
+				got.waitErr = r.Wait()
+				if r.Token.ID != created {
+					t.Errorf("\n%s\nRotateToken(...): want new token %s, got %s", tc.reason, created, r.Token.ID)
+				}
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nRotateToken(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@
 package robots
 
 import (
+	"github.com/google/uuid"
+
 	"github.com/upbound/up-sdk-go/service/common"
 )
 
@@ -61,6 +63,21 @@ type robotCreateRequest struct {
 	Data robotCreateParameters `json:"data"`
 }
 
+// robotUpdateParameters are the parameters for updating a robot, including
+// the non-configurable fields.
+type robotUpdateParameters struct {
+	// Type must always be "robots".
+	Type       bodyType         `json:"type"`
+	ID         uuid.UUID        `json:"id"`
+	Attributes *RobotAttributes `json:"attributes"`
+}
+
+// robotUpdateRequest wraps robotUpdateParameters with the proper request
+// structure for the Upbound API.
+type robotUpdateRequest struct {
+	Data robotUpdateParameters `json:"data"`
+}
+
 // RobotRelationships represents relationships for a robot.
 type RobotRelationships struct {
 	Owner RobotOwner `json:"organization"`
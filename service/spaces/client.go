@@ -15,132 +15,101 @@
 package spaces
 
 import (
-	"bytes"
 	"context"
-	"io"
+	"errors"
 	"net/http"
-	"net/url"
-	"path"
 
-	"k8s.io/apimachinery/pkg/api/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/upbound/up-sdk-go"
 	upboundv1alpha1 "github.com/upbound/up-sdk-go/apis/upbound/v1alpha1"
+	uerrors "github.com/upbound/up-sdk-go/errors"
 )
 
 const (
-	basePath  = "apis/upbound.io/v1alpha1/namespaces"
 	spacePath = "spaces"
 )
 
 // NewClient creates a new spaces client.
 func NewClient(cfg *up.Config) *Client {
-	return &Client{cfg.Client.With(func(c *up.HTTPClient) {
-		c.ErrorHandler = &kubeErrorHandler{}
-	})}
+	return &Client{NewResourceClient(cfg, upboundv1alpha1.SchemeGroupVersion.WithResource(spacePath),
+		func() *upboundv1alpha1.Space { return &upboundv1alpha1.Space{} },
+		func() *upboundv1alpha1.SpaceList { return &upboundv1alpha1.SpaceList{} },
+	)}
 }
 
 // Client is a spaces client.
 type Client struct {
-	uc up.Client
+	rc *ResourceClient[*upboundv1alpha1.Space, *upboundv1alpha1.SpaceList]
 }
 
 // Create creates a space.
 func (c *Client) Create(ctx context.Context, namespace string, space *upboundv1alpha1.Space, opts *metav1.CreateOptions) (*upboundv1alpha1.Space, error) {
-	var params url.Values
-	if opts != nil {
-		p, err := parameterCodec.EncodeParameters(opts, metav1.SchemeGroupVersion)
-		if err != nil {
-			return nil, err
-		}
-		params = p
-	}
-	urlPath := path.Join(basePath, namespace, spacePath)
-	if len(params) > 0 {
-		urlPath += "?" + params.Encode()
-	}
-	req, err := c.uc.NewRequest(ctx, http.MethodPost, "", urlPath, space)
-	if err != nil {
-		return nil, err
-	}
-	res := &upboundv1alpha1.Space{}
-	err = c.uc.Do(req, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
+	return c.rc.Create(ctx, namespace, space, opts)
+}
+
+// Get gets a space.
+func (c *Client) Get(ctx context.Context, namespace, name string, opts *metav1.GetOptions) (*upboundv1alpha1.Space, error) {
+	return c.rc.Get(ctx, namespace, name, opts)
 }
 
-// List lists all accessible space.
+// List lists spaces.
 func (c *Client) List(ctx context.Context, namespace string, opts *metav1.ListOptions) (*upboundv1alpha1.SpaceList, error) {
-	var params url.Values
-	if opts != nil {
-		p, err := parameterCodec.EncodeParameters(opts, metav1.SchemeGroupVersion)
-		if err != nil {
-			return nil, err
-		}
-		params = p
-	}
-	urlPath := path.Join(basePath, namespace, spacePath)
-	if len(params) > 0 {
-		urlPath += "?" + params.Encode()
-	}
-	req, err := c.uc.NewRequest(ctx, http.MethodGet, "", urlPath, nil)
-	if err != nil {
-		return nil, err
-	}
-	res := &upboundv1alpha1.SpaceList{}
-	err = c.uc.Do(req, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
+	return c.rc.List(ctx, namespace, opts)
+}
+
+// Update updates a space.
+func (c *Client) Update(ctx context.Context, namespace string, space *upboundv1alpha1.Space, opts *metav1.UpdateOptions) (*upboundv1alpha1.Space, error) {
+	return c.rc.Update(ctx, namespace, space, opts)
+}
+
+// Patch patches a space. The patch type is usually types.MergePatchType or
+// types.JSONPatchType.
+func (c *Client) Patch(ctx context.Context, namespace, name string, pt types.PatchType, data []byte, opts *metav1.PatchOptions) (*upboundv1alpha1.Space, error) {
+	return c.rc.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+// Watch watches spaces, streaming an event every time one changes.
+func (c *Client) Watch(ctx context.Context, namespace string, opts *metav1.ListOptions) (watch.Interface, error) {
+	return c.rc.Watch(ctx, namespace, opts)
 }
 
 // Delete deletes a space.
 func (c *Client) Delete(ctx context.Context, namespace, name string, opts *metav1.DeleteOptions) error {
-	var params url.Values
-	if opts != nil {
-		p, err := parameterCodec.EncodeParameters(opts, metav1.SchemeGroupVersion)
-		if err != nil {
-			return err
-		}
-		params = p
-	}
-	urlPath := path.Join(basePath, namespace, spacePath, name)
-	if len(params) > 0 {
-		urlPath += "?" + params.Encode()
-	}
-	req, err := c.uc.NewRequest(ctx, http.MethodDelete, "", urlPath, nil)
-	if err != nil {
-		return err
-	}
-	return c.uc.Do(req, nil)
+	return c.rc.Delete(ctx, namespace, name, opts)
 }
 
 var _ up.ResponseErrorHandler = (*kubeErrorHandler)(nil)
 
-type kubeErrorHandler struct{}
+// kubeErrorHandler handles the errors of a Spaces resource. Errors are
+// returned as an *errors.Error like those of every other service, and always
+// wrap a Kubernetes StatusError so that the apierrors helpers work too.
+type kubeErrorHandler struct {
+	up.DefaultErrorHandler
+
+	resource schema.GroupResource
+}
 
-// Handle implements up.ResponseErrorHandler and doesn't intercept errors.
+// Handle implements up.ResponseErrorHandler. Responses whose body is not a
+// Kubernetes Status, e.g. those of a proxy in front of Spaces, get a generic
+// StatusError for their status code.
 func (k *kubeErrorHandler) Handle(res *http.Response) error {
-	if res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusMultipleChoices {
-		return nil
-	}
-	b, err := io.ReadAll(res.Body)
-	if err != nil {
+	err := k.DefaultErrorHandler.Handle(res)
+	var e *uerrors.Error
+	if !errors.As(err, &e) || e.Err != nil {
 		return err
 	}
-	out, _, err := codec.Decode(b, nil, nil)
-	if err != nil {
-		return err
+	verb, msg := "", ""
+	if res.Request != nil {
+		verb = res.Request.Method
 	}
-	// any status besides StatusSuccess is considered an error.
-	if st, ok := out.(*metav1.Status); ok && st.Status != metav1.StatusSuccess {
-		return errors.FromObject(st)
+	if e.Detail != nil {
+		msg = *e.Detail
 	}
-	// reset response body for response reader.
-	res.Body = io.NopCloser(bytes.NewBuffer(b))
-	return nil
+	e.Err = apierrors.NewGenericServerResponse(res.StatusCode, verb, k.resource, "", msg, 0, false)
+	return e
 }
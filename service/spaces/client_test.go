@@ -16,6 +16,7 @@ package spaces
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -24,14 +25,16 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
-	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
 
 	"github.com/upbound/up-sdk-go"
 	upboundv1alpha1 "github.com/upbound/up-sdk-go/apis/upbound/v1alpha1"
+	uerrors "github.com/upbound/up-sdk-go/errors"
 )
 
 func TestClient_Create(t *testing.T) {
@@ -61,16 +64,10 @@ func TestClient_Create(t *testing.T) {
 				}, w)
 			},
 			want: want{
-				err: &errors.StatusError{
-					ErrStatus: metav1.Status{
-						TypeMeta: metav1.TypeMeta{
-							Kind:       "Status",
-							APIVersion: "v1",
-						},
-						Status: metav1.StatusFailure,
-						Code:   http.StatusInternalServerError,
-						Reason: metav1.StatusReasonInternalError,
-					},
+				err: &uerrors.Error{
+					Status: http.StatusInternalServerError,
+					Title:  http.StatusText(http.StatusInternalServerError),
+					Type:   string(metav1.StatusReasonInternalError),
 				},
 			},
 		},
@@ -148,16 +145,10 @@ func TestClient_Delete(t *testing.T) {
 				}, w)
 			},
 			want: want{
-				err: &errors.StatusError{
-					ErrStatus: metav1.Status{
-						TypeMeta: metav1.TypeMeta{
-							Kind:       "Status",
-							APIVersion: "v1",
-						},
-						Status: metav1.StatusFailure,
-						Code:   http.StatusInternalServerError,
-						Reason: metav1.StatusReasonInternalError,
-					},
+				err: &uerrors.Error{
+					Status: http.StatusInternalServerError,
+					Title:  http.StatusText(http.StatusInternalServerError),
+					Type:   string(metav1.StatusReasonInternalError),
 				},
 			},
 		},
@@ -209,3 +200,90 @@ func parseURL(t *testing.T, u string) *url.URL {
 	}
 	return url
 }
+
+func TestClient_Get(t *testing.T) {
+	var got request
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recordRequest(t, &got, r)
+		writeObject(t, &upboundv1alpha1.Space{ObjectMeta: metav1.ObjectMeta{Name: "space-aaaa"}}, w)
+	}))
+	defer s.Close()
+	space, err := NewClient(newTestConfig(t, s)).Get(context.Background(), "test-org", "space-aaaa", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("/apis/upbound.io/v1alpha1/namespaces/test-org/spaces/space-aaaa", got.path); diff != "" {
+		t.Errorf("\nGet(...): -want path, +got path:\n%s", diff)
+	}
+	if diff := cmp.Diff("space-aaaa", space.GetName()); diff != "" {
+		t.Errorf("\nGet(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestClient_Patch(t *testing.T) {
+	type args struct {
+		pt   types.PatchType
+		data []byte
+	}
+	tests := map[string]struct {
+		reason string
+		args   args
+	}{
+		"MergePatch": {
+			reason: "sends a merge patch",
+			args: args{
+				pt:   types.MergePatchType,
+				data: []byte(`{"metadata":{"labels":{"spaces.upbound.io/inaccessible":"true"}}}`),
+			},
+		},
+		"JSONPatch": {
+			reason: "sends a JSON patch",
+			args: args{
+				pt:   types.JSONPatchType,
+				data: []byte(`[{"op":"add","path":"/metadata/labels/spaces.upbound.io~1inaccessible","value":"true"}]`),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got request
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				recordRequest(t, &got, r)
+				writeObject(t, &upboundv1alpha1.Space{ObjectMeta: metav1.ObjectMeta{Name: "space-aaaa"}}, w)
+			}))
+			defer s.Close()
+			if _, err := NewClient(newTestConfig(t, s)).Patch(context.Background(), "test-org", "space-aaaa", tc.args.pt, tc.args.data, nil); err != nil {
+				t.Fatal(err)
+			}
+			want := request{
+				method:      http.MethodPatch,
+				path:        "/apis/upbound.io/v1alpha1/namespaces/test-org/spaces/space-aaaa",
+				contentType: string(tc.args.pt),
+				body:        string(tc.args.data) + "\n",
+			}
+			if diff := cmp.Diff(want, got, cmp.AllowUnexported(request{})); diff != "" {
+				t.Errorf("\n%s\nPatch(...): -want request, +got request:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestClient_Watch(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := json.Marshal(&upboundv1alpha1.Space{ObjectMeta: metav1.ObjectMeta{Name: "space-aaaa"}})
+		_ = json.NewEncoder(w).Encode(&metav1.WatchEvent{Type: string(watch.Modified), Object: runtime.RawExtension{Raw: raw}})
+	}))
+	defer s.Close()
+	w, err := NewClient(newTestConfig(t, s)).Watch(context.Background(), "test-org", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+	e := <-w.ResultChan()
+	if diff := cmp.Diff(watch.Modified, e.Type); diff != "" {
+		t.Errorf("\nWatch(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("space-aaaa", e.Object.(*upboundv1alpha1.Space).GetName()); diff != "" {
+		t.Errorf("\nWatch(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"encoding/json"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientauthv1 "k8s.io/client-go/pkg/apis/clientauthentication/v1"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+	upboundv1alpha1 "github.com/upbound/up-sdk-go/apis/upbound/v1alpha1"
+	"github.com/upbound/up-sdk-go/service/auth"
+)
+
+const (
+	controlPlanesPath = "controlplanes"
+	k8sPath           = "k8s"
+
+	errNoEndpoint    = "space has no FQDN or API URL in its status"
+	errParseEndpoint = "cannot parse space endpoint"
+	errNoCredentials = "a token or exec credential plugin is required"
+	errNoAccessToken = "token response has no access token"
+)
+
+// A KubeconfigOption modifies how a kubeconfig is built.
+type KubeconfigOption func(*kubeconfigOptions)
+
+type kubeconfigOptions struct {
+	name     string
+	token    string
+	exec     *clientcmdapi.ExecConfig
+	caData   []byte
+	insecure bool
+}
+
+// WithKubeconfigName sets the name of the cluster, user and context in the
+// kubeconfig. It defaults to a name derived from the Space and control plane.
+func WithKubeconfigName(name string) KubeconfigOption {
+	return func(o *kubeconfigOptions) {
+		o.name = name
+	}
+}
+
+// WithToken authenticates with a static token, usually the access token
+// returned by auth.Client.GetOrgScopedToken.
+func WithToken(token string) KubeconfigOption {
+	return func(o *kubeconfigOptions) {
+		o.token = token
+	}
+}
+
+// WithExecCredential authenticates by running the supplied command, which
+// must print an ExecCredential such as the one returned by ExecCredential.
+// This lets kubectl refresh expired tokens. It takes precedence over
+// WithToken.
+func WithExecCredential(command string, args ...string) KubeconfigOption {
+	return func(o *kubeconfigOptions) {
+		o.exec = &clientcmdapi.ExecConfig{
+			APIVersion:      clientauthv1.SchemeGroupVersion.String(),
+			Command:         command,
+			Args:            args,
+			InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+		}
+	}
+}
+
+// WithCertificateAuthority sets the PEM encoded CA certificate used to verify
+// the Space's ingress. The system roots are used if it is not set.
+func WithCertificateAuthority(data []byte) KubeconfigOption {
+	return func(o *kubeconfigOptions) {
+		o.caData = data
+	}
+}
+
+// WithInsecureSkipTLSVerify disables verification of the Space's ingress
+// certificate. It should only be used for development.
+func WithInsecureSkipTLSVerify() KubeconfigOption {
+	return func(o *kubeconfigOptions) {
+		o.insecure = true
+	}
+}
+
+// SpaceKubeconfig builds a kubeconfig for the Space API of the supplied Space.
+func SpaceKubeconfig(space *upboundv1alpha1.Space, opts ...KubeconfigOption) (*clientcmdapi.Config, error) {
+	server, err := spaceEndpoint(space)
+	if err != nil {
+		return nil, err
+	}
+	return kubeconfig(server.String(), path.Join(space.GetNamespace(), space.GetName()), "", opts...)
+}
+
+// ControlPlaneKubeconfig builds a kubeconfig for the named control plane in
+// the supplied group of the Space. The context uses the default namespace of
+// the control plane.
+func ControlPlaneKubeconfig(space *upboundv1alpha1.Space, group, name string, opts ...KubeconfigOption) (*clientcmdapi.Config, error) {
+	server, err := spaceEndpoint(space)
+	if err != nil {
+		return nil, err
+	}
+	server.Path = "/" + path.Join(apisPath, spacesv1beta1.Group, spacesv1beta1.Version, namespacesPath, group, controlPlanesPath, name, k8sPath)
+	return kubeconfig(server.String(), path.Join(space.GetNamespace(), space.GetName(), group, name), "default", opts...)
+}
+
+// ExecCredential returns the ExecCredential that an exec credential plugin
+// prints to pass the token in the supplied response to kubectl. The token
+// expires ExpiresIn seconds after now.
+func ExecCredential(res *auth.TokenExchangeResponse, now time.Time) ([]byte, error) {
+	if res == nil || res.AccessToken == "" {
+		return nil, errors.New(errNoAccessToken)
+	}
+	ec := &clientauthv1.ExecCredential{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: clientauthv1.SchemeGroupVersion.String(),
+			Kind:       "ExecCredential",
+		},
+		Status: &clientauthv1.ExecCredentialStatus{
+			Token: res.AccessToken,
+		},
+	}
+	if res.ExpiresIn > 0 {
+		exp := metav1.NewTime(now.Add(time.Duration(res.ExpiresIn) * time.Second))
+		ec.Status.ExpirationTimestamp = &exp
+	}
+	return json.Marshal(ec)
+}
+
+// spaceEndpoint returns the ingress of the Space, falling back to its API URL
+// if the FQDN is not known.
+func spaceEndpoint(space *upboundv1alpha1.Space) (*url.URL, error) {
+	if space == nil {
+		return nil, errors.New(errNoEndpoint)
+	}
+	endpoint := space.Status.FQDN
+	if endpoint == "" {
+		endpoint = space.Status.APIURL
+	}
+	if endpoint == "" {
+		return nil, errors.New(errNoEndpoint)
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseEndpoint)
+	}
+	return &url.URL{Scheme: u.Scheme, Host: u.Host}, nil
+}
+
+func kubeconfig(server, name, namespace string, opts ...KubeconfigOption) (*clientcmdapi.Config, error) {
+	o := &kubeconfigOptions{name: name}
+	for _, fn := range opts {
+		fn(o)
+	}
+	if o.token == "" && o.exec == nil {
+		return nil, errors.New(errNoCredentials)
+	}
+
+	cluster := clientcmdapi.NewCluster()
+	cluster.Server = server
+	cluster.CertificateAuthorityData = o.caData
+	cluster.InsecureSkipTLSVerify = o.insecure
+
+	user := clientcmdapi.NewAuthInfo()
+	if o.exec != nil {
+		user.Exec = o.exec
+	} else {
+		user.Token = o.token
+	}
+
+	ctx := clientcmdapi.NewContext()
+	ctx.Cluster = o.name
+	ctx.AuthInfo = o.name
+	ctx.Namespace = namespace
+
+	cfg := clientcmdapi.NewConfig()
+	cfg.Clusters[o.name] = cluster
+	cfg.AuthInfos[o.name] = user
+	cfg.Contexts[o.name] = ctx
+	cfg.CurrentContext = o.name
+	return cfg, nil
+}
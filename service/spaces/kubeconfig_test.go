@@ -0,0 +1,159 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	upboundv1alpha1 "github.com/upbound/up-sdk-go/apis/upbound/v1alpha1"
+	"github.com/upbound/up-sdk-go/service/auth"
+)
+
+func testSpace(fqdn, apiURL string) *upboundv1alpha1.Space {
+	return &upboundv1alpha1.Space{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "org", Name: "space"},
+		Status:     upboundv1alpha1.SpaceStatus{FQDN: fqdn, APIURL: apiURL},
+	}
+}
+
+func testKubeconfig(name, server, namespace string, user *clientcmdapi.AuthInfo) *clientcmdapi.Config {
+	cfg := clientcmdapi.NewConfig()
+	cluster := clientcmdapi.NewCluster()
+	cluster.Server = server
+	ctx := clientcmdapi.NewContext()
+	ctx.Cluster = name
+	ctx.AuthInfo = name
+	ctx.Namespace = namespace
+	cfg.Clusters[name] = cluster
+	cfg.AuthInfos[name] = user
+	cfg.Contexts[name] = ctx
+	cfg.CurrentContext = name
+	return cfg
+}
+
+func TestSpaceKubeconfig(t *testing.T) {
+	type want struct {
+		cfg *clientcmdapi.Config
+		err error
+	}
+	tests := map[string]struct {
+		reason string
+		space  *upboundv1alpha1.Space
+		opts   []KubeconfigOption
+		want   want
+	}{
+		"NoEndpoint": {
+			reason: "returns an error if the space has no endpoint",
+			space:  testSpace("", ""),
+			opts:   []KubeconfigOption{WithToken("token")},
+			want:   want{err: errors.New(errNoEndpoint)},
+		},
+		"NoCredentials": {
+			reason: "returns an error if no credentials are supplied",
+			space:  testSpace("space.upbound.io", ""),
+			want:   want{err: errors.New(errNoCredentials)},
+		},
+		"FQDN": {
+			reason: "uses the ingress of the space",
+			space:  testSpace("space.upbound.io", "https://api.upbound.io/spaces/space"),
+			opts:   []KubeconfigOption{WithToken("token")},
+			want: want{
+				cfg: testKubeconfig("org/space", "https://space.upbound.io", "", &clientcmdapi.AuthInfo{Token: "token"}),
+			},
+		},
+		"APIURL": {
+			reason: "falls back to the API URL of the space",
+			space:  testSpace("", "https://api.upbound.io/spaces/space"),
+			opts:   []KubeconfigOption{WithToken("token"), WithKubeconfigName("upbound")},
+			want: want{
+				cfg: testKubeconfig("upbound", "https://api.upbound.io", "", &clientcmdapi.AuthInfo{Token: "token"}),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := SpaceKubeconfig(tc.space, tc.opts...)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nSpaceKubeconfig(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cfg, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nSpaceKubeconfig(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestControlPlaneKubeconfig(t *testing.T) {
+	got, err := ControlPlaneKubeconfig(testSpace("space.upbound.io", ""), "default", "ctp", WithExecCredential("up", "token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := testKubeconfig("org/space/default/ctp", "https://space.upbound.io/apis/spaces.upbound.io/v1beta1/namespaces/default/controlplanes/ctp/k8s", "default", &clientcmdapi.AuthInfo{
+		Exec: &clientcmdapi.ExecConfig{
+			APIVersion:      "client.authentication.k8s.io/v1",
+			Command:         "up",
+			Args:            []string{"token"},
+			InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+		},
+	})
+	if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("\nControlPlaneKubeconfig(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestExecCredential(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	type want struct {
+		out string
+		err error
+	}
+	tests := map[string]struct {
+		reason string
+		res    *auth.TokenExchangeResponse
+		want   want
+	}{
+		"NoToken": {
+			reason: "returns an error if there is no access token",
+			res:    &auth.TokenExchangeResponse{},
+			want:   want{err: errors.New(errNoAccessToken)},
+		},
+		"Expiring": {
+			reason: "returns the token and its expiry",
+			res:    &auth.TokenExchangeResponse{AccessToken: "token", ExpiresIn: 60},
+			want: want{
+				out: `{"kind":"ExecCredential","apiVersion":"client.authentication.k8s.io/v1","spec":{"interactive":false},"status":{"expirationTimestamp":"2025-01-01T00:01:00Z","token":"token"}}`,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ExecCredential(tc.res, now)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nExecCredential(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.out, string(got)); diff != "" {
+				t.Errorf("\n%s\nExecCredential(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
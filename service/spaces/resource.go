@@ -0,0 +1,248 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"reflect"
+
+	pkgerrors "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/upbound/up-sdk-go"
+)
+
+const (
+	apisPath       = "apis"
+	namespacesPath = "namespaces"
+
+	errWatchClient = "watch requires a client that implements up.Streamer"
+	errDecodeEvent = "cannot decode watch event"
+)
+
+// Object is a Kubernetes object served by the Spaces API.
+type Object interface {
+	runtime.Object
+	metav1.Object
+}
+
+// A ResourceClient reads and writes a single kind of namespaced resource
+// through the Spaces API served by Upbound. O is a pointer to the object type
+// and L a pointer to its list type, e.g. *v1beta1.ControlPlane and
+// *v1beta1.ControlPlaneList.
+type ResourceClient[O Object, L runtime.Object] struct {
+	uc      up.Client
+	gvr     schema.GroupVersionResource
+	newObj  func() O
+	newList func() L
+}
+
+// NewResourceClient builds a ResourceClient for the supplied resource. newObj
+// and newList return empty objects that responses are decoded into.
+func NewResourceClient[O Object, L runtime.Object](cfg *up.Config, gvr schema.GroupVersionResource, newObj func() O, newList func() L) *ResourceClient[O, L] {
+	return &ResourceClient[O, L]{
+		uc: cfg.Client.With(func(c *up.HTTPClient) {
+			c.ErrorHandler = &kubeErrorHandler{resource: gvr.GroupResource()}
+		}),
+		gvr:     gvr,
+		newObj:  newObj,
+		newList: newList,
+	}
+}
+
+// Get returns the named object.
+func (c *ResourceClient[O, L]) Get(ctx context.Context, namespace, name string, opts *metav1.GetOptions) (O, error) {
+	res := c.newObj()
+	if err := c.do(ctx, http.MethodGet, c.path(namespace, name), opts, nil, res); err != nil {
+		var zero O
+		return zero, err
+	}
+	return res, nil
+}
+
+// List returns the objects in the namespace, or in all namespaces if it is
+// empty.
+func (c *ResourceClient[O, L]) List(ctx context.Context, namespace string, opts *metav1.ListOptions) (L, error) {
+	res := c.newList()
+	if err := c.do(ctx, http.MethodGet, c.path(namespace, ""), opts, nil, res); err != nil {
+		var zero L
+		return zero, err
+	}
+	return res, nil
+}
+
+// Create creates the object in the namespace and returns the created object.
+func (c *ResourceClient[O, L]) Create(ctx context.Context, namespace string, obj O, opts *metav1.CreateOptions) (O, error) {
+	res := c.newObj()
+	if err := c.do(ctx, http.MethodPost, c.path(namespace, ""), opts, c.withKind(obj), res); err != nil {
+		var zero O
+		return zero, err
+	}
+	return res, nil
+}
+
+// Update replaces the object in the namespace and returns the updated object.
+func (c *ResourceClient[O, L]) Update(ctx context.Context, namespace string, obj O, opts *metav1.UpdateOptions) (O, error) {
+	res := c.newObj()
+	if err := c.do(ctx, http.MethodPut, c.path(namespace, obj.GetName()), opts, c.withKind(obj), res); err != nil {
+		var zero O
+		return zero, err
+	}
+	return res, nil
+}
+
+// Patch applies the patch of the supplied type to the named object and
+// returns the patched object.
+func (c *ResourceClient[O, L]) Patch(ctx context.Context, namespace, name string, pt types.PatchType, data []byte, opts *metav1.PatchOptions) (O, error) {
+	res := c.newObj()
+	req, err := c.newRequest(ctx, http.MethodPatch, c.path(namespace, name), opts, json.RawMessage(data))
+	if err != nil {
+		var zero O
+		return zero, err
+	}
+	req.Header.Set("Content-Type", string(pt))
+	if err := c.uc.Do(req, res); err != nil {
+		var zero O
+		return zero, err
+	}
+	return res, nil
+}
+
+// Delete deletes the named object.
+func (c *ResourceClient[O, L]) Delete(ctx context.Context, namespace, name string, opts *metav1.DeleteOptions) error {
+	return c.do(ctx, http.MethodDelete, c.path(namespace, name), opts, nil, nil)
+}
+
+// Watch watches the objects in the namespace, or in all namespaces if it is
+// empty. The watch is closed when ctx is done or Stop is called on the
+// returned watch.Interface. Watch requires the client to implement
+// up.Streamer.
+func (c *ResourceClient[O, L]) Watch(ctx context.Context, namespace string, opts *metav1.ListOptions) (watch.Interface, error) {
+	s, ok := c.uc.(up.Streamer)
+	if !ok {
+		return nil, pkgerrors.New(errWatchClient)
+	}
+	o := &metav1.ListOptions{}
+	if opts != nil {
+		o = opts.DeepCopy()
+	}
+	o.Watch = true
+	req, err := c.newRequest(ctx, http.MethodGet, c.path(namespace, ""), o, nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := s.Stream(req)
+	if err != nil {
+		return nil, err
+	}
+	return watch.NewStreamWatcher(&eventDecoder[O]{body: body, dec: json.NewDecoder(body), newObj: c.newObj}, errorReporter{}), nil
+}
+
+// prefix returns the service prefix of the resource's API group version.
+func (c *ResourceClient[O, L]) prefix() string {
+	return path.Join(apisPath, c.gvr.Group, c.gvr.Version)
+}
+
+// path returns the path of the named object in the namespace, relative to the
+// prefix. The namespace and name are omitted if empty.
+func (c *ResourceClient[O, L]) path(namespace, name string) string {
+	p := ""
+	if namespace != "" {
+		p = path.Join(namespacesPath, namespace)
+	}
+	return path.Join(p, c.gvr.Resource, name)
+}
+
+// withKind sets the kind of the object, which the API server requires.
+func (c *ResourceClient[O, L]) withKind(obj O) O {
+	if reflect.ValueOf(obj).IsNil() || !obj.GetObjectKind().GroupVersionKind().Empty() {
+		return obj
+	}
+	if gvks, _, err := scheme.ObjectKinds(obj); err == nil && len(gvks) > 0 {
+		obj = obj.DeepCopyObject().(O) //nolint:forcetypeassert // DeepCopyObject returns the same type.
+		obj.GetObjectKind().SetGroupVersionKind(gvks[0])
+	}
+	return obj
+}
+
+func (c *ResourceClient[O, L]) do(ctx context.Context, method, urlPath string, opts runtime.Object, body, obj any) error {
+	req, err := c.newRequest(ctx, method, urlPath, opts, body)
+	if err != nil {
+		return err
+	}
+	return c.uc.Do(req, obj)
+}
+
+func (c *ResourceClient[O, L]) newRequest(ctx context.Context, method, urlPath string, opts runtime.Object, body any) (*http.Request, error) {
+	params, err := encodeParams(opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(params) > 0 {
+		urlPath += "?" + params.Encode()
+	}
+	return c.uc.NewRequest(ctx, method, c.prefix(), urlPath, body)
+}
+
+// encodeParams encodes the supplied options as query parameters.
+func encodeParams(opts runtime.Object) (url.Values, error) {
+	if opts == nil || reflect.ValueOf(opts).IsNil() {
+		return nil, nil
+	}
+	return parameterCodec.EncodeParameters(opts, metav1.SchemeGroupVersion)
+}
+
+// eventDecoder decodes the events of a watch stream.
+type eventDecoder[O Object] struct {
+	body   io.ReadCloser
+	dec    *json.Decoder
+	newObj func() O
+}
+
+func (d *eventDecoder[O]) Decode() (watch.EventType, runtime.Object, error) {
+	e := &metav1.WatchEvent{}
+	if err := d.dec.Decode(e); err != nil {
+		return "", nil, err
+	}
+	var obj runtime.Object = d.newObj()
+	if watch.EventType(e.Type) == watch.Error {
+		obj = &metav1.Status{}
+	}
+	if err := json.Unmarshal(e.Object.Raw, obj); err != nil {
+		return "", nil, pkgerrors.Wrap(err, errDecodeEvent)
+	}
+	return watch.EventType(e.Type), obj, nil
+}
+
+func (d *eventDecoder[O]) Close() {
+	d.body.Close() //nolint:errcheck,gosec // Nothing to do with the error.
+}
+
+// errorReporter reports errors decoding a watch stream as a Status.
+type errorReporter struct{}
+
+func (errorReporter) AsObject(err error) runtime.Object {
+	return &errors.NewInternalError(err).ErrStatus
+}
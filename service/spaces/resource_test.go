@@ -0,0 +1,299 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/upbound/up-sdk-go"
+	spacesv1alpha1 "github.com/upbound/up-sdk-go/apis/spaces/v1alpha1"
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+	uerrors "github.com/upbound/up-sdk-go/errors"
+)
+
+// request records what a test server received.
+type request struct {
+	method      string
+	path        string
+	query       string
+	contentType string
+	body        string
+}
+
+func recordRequest(t *testing.T, got *request, r *http.Request) {
+	t.Helper()
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	*got = request{
+		method:      r.Method,
+		path:        r.URL.Path,
+		query:       r.URL.RawQuery,
+		contentType: r.Header.Get("Content-Type"),
+		body:        string(b),
+	}
+}
+
+func writeJSON(t *testing.T, obj any, w io.Writer) {
+	t.Helper()
+	if err := json.NewEncoder(w).Encode(obj); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newTestConfig(t *testing.T, s *httptest.Server) *up.Config {
+	t.Helper()
+	return up.NewConfig(func(cfg *up.Config) {
+		cfg.Client = up.NewClient(func(u *up.HTTPClient) {
+			u.BaseURL = parseURL(t, s.URL)
+			u.HTTP = s.Client()
+		})
+	})
+}
+
+func TestResourceClient_Get(t *testing.T) {
+	notFound := `controlplanes.spaces.upbound.io "ctp" not found`
+	noRoute := "no route"
+	type want struct {
+		req request
+		obj *spacesv1beta1.ControlPlane
+		err error
+	}
+	tests := map[string]struct {
+		reason  string
+		handler func(t *testing.T) http.HandlerFunc
+		ns      string
+		name    string
+		opts    *metav1.GetOptions
+		want    want
+	}{
+		"NotFound": {
+			reason: "returns a not found status error",
+			handler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					writeObject(t, &errors.NewNotFound(spacesv1beta1.SchemeGroupVersion.WithResource("controlplanes").GroupResource(), "ctp").ErrStatus, w)
+				}
+			},
+			ns:   "default",
+			name: "ctp",
+			want: want{
+				req: request{method: http.MethodGet, path: "/apis/spaces.upbound.io/v1beta1/namespaces/default/controlplanes/ctp"},
+				err: &uerrors.Error{Status: http.StatusNotFound, Title: http.StatusText(http.StatusNotFound), Detail: &notFound},
+			},
+		},
+		"NotFoundWithoutStatus": {
+			reason: "returns a not found error that wraps a status error when the body is not a status",
+			handler: func(_ *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(noRoute))
+				}
+			},
+			ns:   "default",
+			name: "ctp",
+			want: want{
+				req: request{method: http.MethodGet, path: "/apis/spaces.upbound.io/v1beta1/namespaces/default/controlplanes/ctp"},
+				err: &uerrors.Error{Status: http.StatusNotFound, Title: http.StatusText(http.StatusNotFound), Detail: &noRoute},
+			},
+		},
+		"Success": {
+			reason: "returns the control plane",
+			handler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					writeJSON(t, &spacesv1beta1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "ctp", Namespace: "default"}}, w)
+				}
+			},
+			ns:   "default",
+			name: "ctp",
+			opts: &metav1.GetOptions{ResourceVersion: "1"},
+			want: want{
+				req: request{method: http.MethodGet, path: "/apis/spaces.upbound.io/v1beta1/namespaces/default/controlplanes/ctp", query: "resourceVersion=1"},
+				obj: &spacesv1beta1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "ctp", Namespace: "default"}},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got request
+			h := tc.handler(t)
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				recordRequest(t, &got, r)
+				h(w, r)
+			}))
+			defer s.Close()
+			obj, err := NewControlPlaneClient(newTestConfig(t, s)).Get(context.Background(), tc.ns, tc.name, tc.opts)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.IgnoreTypes(metav1.TypeMeta{}), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.err != nil {
+				if !stderrors.Is(err, uerrors.ErrNotFound) || !errors.IsNotFound(err) {
+					t.Errorf("\n%s\nGet(...): want an error matching both uerrors.ErrNotFound and Kubernetes NotFound, got %v", tc.reason, err)
+				}
+				if e, _ := uerrors.From(err); e.RequestID == "" || e.Method != http.MethodGet || e.URL != s.URL+tc.want.req.path {
+					t.Errorf("\n%s\nGet(...): want the error to record the request, got %+v", tc.reason, e)
+				}
+			}
+			if diff := cmp.Diff(tc.want.obj, obj, cmpopts.IgnoreTypes(metav1.TypeMeta{})); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.req, got, cmp.AllowUnexported(request{})); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want request, +got request:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestResourceClient_List(t *testing.T) {
+	var got request
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recordRequest(t, &got, r)
+		writeJSON(t, &spacesv1alpha1.BackupList{Items: []spacesv1alpha1.Backup{{ObjectMeta: metav1.ObjectMeta{Name: "b"}}}}, w)
+	}))
+	defer s.Close()
+	l, err := NewBackupClient(newTestConfig(t, s)).List(context.Background(), "", &metav1.ListOptions{LabelSelector: "a=b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := request{method: http.MethodGet, path: "/apis/spaces.upbound.io/v1alpha1/backups", query: "labelSelector=a%3Db"}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(request{})); diff != "" {
+		t.Errorf("\nList(...): objects in all namespaces should be listed: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(1, len(l.Items)); diff != "" {
+		t.Errorf("\nList(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestResourceClient_Update(t *testing.T) {
+	var got request
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recordRequest(t, &got, r)
+		_, _ = w.Write([]byte(got.body))
+	}))
+	defer s.Close()
+	in := &spacesv1alpha1.Simulation{ObjectMeta: metav1.ObjectMeta{Name: "sim", Namespace: "default"}}
+	if _, err := NewSimulationClient(newTestConfig(t, s)).Update(context.Background(), "default", in, nil); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("/apis/spaces.upbound.io/v1alpha1/namespaces/default/simulations/sim", got.path); diff != "" {
+		t.Errorf("\nUpdate(...): -want path, +got path:\n%s", diff)
+	}
+	sent := &metav1.TypeMeta{}
+	if err := json.Unmarshal([]byte(got.body), sent); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&metav1.TypeMeta{APIVersion: "spaces.upbound.io/v1alpha1", Kind: "Simulation"}, sent); diff != "" {
+		t.Errorf("\nUpdate(...): the kind of the object should be sent: -want, +got:\n%s", diff)
+	}
+	if !in.GetObjectKind().GroupVersionKind().Empty() {
+		t.Errorf("\nUpdate(...): the supplied object should not be modified")
+	}
+}
+
+func TestResourceClient_Patch(t *testing.T) {
+	var got request
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recordRequest(t, &got, r)
+		writeJSON(t, &spacesv1beta1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "ctp", Labels: map[string]string{"a": "b"}}}, w)
+	}))
+	defer s.Close()
+	patch := []byte(`{"metadata":{"labels":{"a":"b"}}}`)
+	obj, err := NewControlPlaneClient(newTestConfig(t, s)).Patch(context.Background(), "default", "ctp", types.MergePatchType, patch, &metav1.PatchOptions{FieldManager: "up"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := request{
+		method:      http.MethodPatch,
+		path:        "/apis/spaces.upbound.io/v1beta1/namespaces/default/controlplanes/ctp",
+		query:       "fieldManager=up",
+		contentType: string(types.MergePatchType),
+		body:        string(patch) + "\n",
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(request{})); diff != "" {
+		t.Errorf("\nPatch(...): -want request, +got request:\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{"a": "b"}, obj.GetLabels()); diff != "" {
+		t.Errorf("\nPatch(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestResourceClient_Watch(t *testing.T) {
+	var got request
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recordRequest(t, &got, r)
+		for _, e := range []struct {
+			t   watch.EventType
+			obj runtime.Object
+		}{
+			{watch.Added, &spacesv1beta1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "ctp"}}},
+			{watch.Modified, &spacesv1beta1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "ctp", ResourceVersion: "2"}}},
+		} {
+			raw, _ := json.Marshal(e.obj)
+			_ = json.NewEncoder(w).Encode(&metav1.WatchEvent{Type: string(e.t), Object: runtime.RawExtension{Raw: raw}})
+			w.(http.Flusher).Flush()
+		}
+	}))
+	defer s.Close()
+
+	w, err := NewControlPlaneClient(newTestConfig(t, s)).Watch(context.Background(), "default", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+
+	type event struct {
+		t       watch.EventType
+		name    string
+		version string
+	}
+	var events []event
+	timeout := time.After(5 * time.Second)
+	for len(events) < 2 {
+		select {
+		case e, ok := <-w.ResultChan():
+			if !ok {
+				t.Fatalf("\nWatch(...): the watch closed early, got %v", events)
+			}
+			cp := e.Object.(*spacesv1beta1.ControlPlane)
+			events = append(events, event{t: e.Type, name: cp.GetName(), version: cp.GetResourceVersion()})
+		case <-timeout:
+			t.Fatalf("\nWatch(...): timed out waiting for events, got %v", events)
+		}
+	}
+	want := []event{{t: watch.Added, name: "ctp"}, {t: watch.Modified, name: "ctp", version: "2"}}
+	if diff := cmp.Diff(want, events, cmp.AllowUnexported(event{})); diff != "" {
+		t.Errorf("\nWatch(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("watch=true", got.query); diff != "" {
+		t.Errorf("\nWatch(...): -want query, +got query:\n%s", diff)
+	}
+}
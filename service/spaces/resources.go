@@ -0,0 +1,135 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"github.com/upbound/up-sdk-go"
+	adminv1alpha1 "github.com/upbound/up-sdk-go/apis/admin/v1alpha1"
+	spacesv1alpha1 "github.com/upbound/up-sdk-go/apis/spaces/v1alpha1"
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+)
+
+// NewControlPlaneClient builds a client for spaces.upbound.io ControlPlanes.
+func NewControlPlaneClient(cfg *up.Config) *ResourceClient[*spacesv1beta1.ControlPlane, *spacesv1beta1.ControlPlaneList] {
+	return NewResourceClient(cfg, spacesv1beta1.SchemeGroupVersion.WithResource("controlplanes"),
+		func() *spacesv1beta1.ControlPlane { return &spacesv1beta1.ControlPlane{} },
+		func() *spacesv1beta1.ControlPlaneList { return &spacesv1beta1.ControlPlaneList{} },
+	)
+}
+
+// NewBackupClient builds a client for spaces.upbound.io Backups.
+func NewBackupClient(cfg *up.Config) *ResourceClient[*spacesv1alpha1.Backup, *spacesv1alpha1.BackupList] {
+	return NewResourceClient(cfg, spacesv1alpha1.SchemeGroupVersion.WithResource("backups"),
+		func() *spacesv1alpha1.Backup { return &spacesv1alpha1.Backup{} },
+		func() *spacesv1alpha1.BackupList { return &spacesv1alpha1.BackupList{} },
+	)
+}
+
+// NewBackupScheduleClient builds a client for spaces.upbound.io
+// BackupSchedules.
+func NewBackupScheduleClient(cfg *up.Config) *ResourceClient[*spacesv1alpha1.BackupSchedule, *spacesv1alpha1.BackupScheduleList] {
+	return NewResourceClient(cfg, spacesv1alpha1.SchemeGroupVersion.WithResource("backupschedules"),
+		func() *spacesv1alpha1.BackupSchedule { return &spacesv1alpha1.BackupSchedule{} },
+		func() *spacesv1alpha1.BackupScheduleList { return &spacesv1alpha1.BackupScheduleList{} },
+	)
+}
+
+// NewSharedBackupClient builds a client for spaces.upbound.io SharedBackups.
+func NewSharedBackupClient(cfg *up.Config) *ResourceClient[*spacesv1alpha1.SharedBackup, *spacesv1alpha1.SharedBackupList] {
+	return NewResourceClient(cfg, spacesv1alpha1.SchemeGroupVersion.WithResource("sharedbackups"),
+		func() *spacesv1alpha1.SharedBackup { return &spacesv1alpha1.SharedBackup{} },
+		func() *spacesv1alpha1.SharedBackupList { return &spacesv1alpha1.SharedBackupList{} },
+	)
+}
+
+// NewSharedBackupConfigClient builds a client for spaces.upbound.io
+// SharedBackupConfigs.
+func NewSharedBackupConfigClient(cfg *up.Config) *ResourceClient[*spacesv1alpha1.SharedBackupConfig, *spacesv1alpha1.SharedBackupConfigList] {
+	return NewResourceClient(cfg, spacesv1alpha1.SchemeGroupVersion.WithResource("sharedbackupconfigs"),
+		func() *spacesv1alpha1.SharedBackupConfig { return &spacesv1alpha1.SharedBackupConfig{} },
+		func() *spacesv1alpha1.SharedBackupConfigList { return &spacesv1alpha1.SharedBackupConfigList{} },
+	)
+}
+
+// NewSharedBackupScheduleClient builds a client for spaces.upbound.io
+// SharedBackupSchedules.
+func NewSharedBackupScheduleClient(cfg *up.Config) *ResourceClient[*spacesv1alpha1.SharedBackupSchedule, *spacesv1alpha1.SharedBackupScheduleList] {
+	return NewResourceClient(cfg, spacesv1alpha1.SchemeGroupVersion.WithResource("sharedbackupschedules"),
+		func() *spacesv1alpha1.SharedBackupSchedule { return &spacesv1alpha1.SharedBackupSchedule{} },
+		func() *spacesv1alpha1.SharedBackupScheduleList { return &spacesv1alpha1.SharedBackupScheduleList{} },
+	)
+}
+
+// NewSharedSecretStoreClient builds a client for spaces.upbound.io
+// SharedSecretStores.
+func NewSharedSecretStoreClient(cfg *up.Config) *ResourceClient[*spacesv1alpha1.SharedSecretStore, *spacesv1alpha1.SharedSecretStoreList] {
+	return NewResourceClient(cfg, spacesv1alpha1.SchemeGroupVersion.WithResource("sharedsecretstores"),
+		func() *spacesv1alpha1.SharedSecretStore { return &spacesv1alpha1.SharedSecretStore{} },
+		func() *spacesv1alpha1.SharedSecretStoreList { return &spacesv1alpha1.SharedSecretStoreList{} },
+	)
+}
+
+// NewSharedExternalSecretClient builds a client for spaces.upbound.io
+// SharedExternalSecrets.
+func NewSharedExternalSecretClient(cfg *up.Config) *ResourceClient[*spacesv1alpha1.SharedExternalSecret, *spacesv1alpha1.SharedExternalSecretList] {
+	return NewResourceClient(cfg, spacesv1alpha1.SchemeGroupVersion.WithResource("sharedexternalsecrets"),
+		func() *spacesv1alpha1.SharedExternalSecret { return &spacesv1alpha1.SharedExternalSecret{} },
+		func() *spacesv1alpha1.SharedExternalSecretList { return &spacesv1alpha1.SharedExternalSecretList{} },
+	)
+}
+
+// NewSimulationClient builds a client for spaces.upbound.io Simulations.
+func NewSimulationClient(cfg *up.Config) *ResourceClient[*spacesv1alpha1.Simulation, *spacesv1alpha1.SimulationList] {
+	return NewResourceClient(cfg, spacesv1alpha1.SchemeGroupVersion.WithResource("simulations"),
+		func() *spacesv1alpha1.Simulation { return &spacesv1alpha1.Simulation{} },
+		func() *spacesv1alpha1.SimulationList { return &spacesv1alpha1.SimulationList{} },
+	)
+}
+
+// NewInControlPlaneOverrideClient builds a client for spaces.upbound.io
+// InControlPlaneOverrides.
+func NewInControlPlaneOverrideClient(cfg *up.Config) *ResourceClient[*spacesv1alpha1.InControlPlaneOverride, *spacesv1alpha1.InControlPlaneOverrideList] {
+	return NewResourceClient(cfg, spacesv1alpha1.SchemeGroupVersion.WithResource("incontrolplaneoverrides"),
+		func() *spacesv1alpha1.InControlPlaneOverride { return &spacesv1alpha1.InControlPlaneOverride{} },
+		func() *spacesv1alpha1.InControlPlaneOverrideList { return &spacesv1alpha1.InControlPlaneOverrideList{} },
+	)
+}
+
+// NewSpaceBackupClient builds a client for admin.spaces.upbound.io
+// SpaceBackups.
+func NewSpaceBackupClient(cfg *up.Config) *ResourceClient[*adminv1alpha1.SpaceBackup, *adminv1alpha1.SpaceBackupList] {
+	return NewResourceClient(cfg, adminv1alpha1.SchemeGroupVersion.WithResource("spacebackups"),
+		func() *adminv1alpha1.SpaceBackup { return &adminv1alpha1.SpaceBackup{} },
+		func() *adminv1alpha1.SpaceBackupList { return &adminv1alpha1.SpaceBackupList{} },
+	)
+}
+
+// NewSpaceBackupConfigClient builds a client for admin.spaces.upbound.io
+// SpaceBackupConfigs.
+func NewSpaceBackupConfigClient(cfg *up.Config) *ResourceClient[*adminv1alpha1.SpaceBackupConfig, *adminv1alpha1.SpaceBackupConfigList] {
+	return NewResourceClient(cfg, adminv1alpha1.SchemeGroupVersion.WithResource("spacebackupconfigs"),
+		func() *adminv1alpha1.SpaceBackupConfig { return &adminv1alpha1.SpaceBackupConfig{} },
+		func() *adminv1alpha1.SpaceBackupConfigList { return &adminv1alpha1.SpaceBackupConfigList{} },
+	)
+}
+
+// NewSpaceBackupScheduleClient builds a client for admin.spaces.upbound.io
+// SpaceBackupSchedules.
+func NewSpaceBackupScheduleClient(cfg *up.Config) *ResourceClient[*adminv1alpha1.SpaceBackupSchedule, *adminv1alpha1.SpaceBackupScheduleList] {
+	return NewResourceClient(cfg, adminv1alpha1.SchemeGroupVersion.WithResource("spacebackupschedules"),
+		func() *adminv1alpha1.SpaceBackupSchedule { return &adminv1alpha1.SpaceBackupSchedule{} },
+		func() *adminv1alpha1.SpaceBackupScheduleList { return &adminv1alpha1.SpaceBackupScheduleList{} },
+	)
+}
@@ -16,30 +16,81 @@ package teams
 
 import (
 	"context"
+	"iter"
 	"net/http"
+	"path"
+	"strconv"
+	"sync"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/common"
 )
 
 const (
-	basePath = "v1/teams"
+	basePath          = "v1/teams"
+	membersPath       = "members"
+	robotsPath        = "robots"
+	robotsBasePath    = "v2/robots"
+	teamsRelationPath = "relationships/teams"
+	teamsType         = "teams"
+
+	organizationIDParam = "organizationId"
+
+	errTeamNotFound = "could not find a team with given name"
 )
 
 // Client is an teams client.
 type Client struct {
 	*up.Config
+
+	teamIDs     *common.Resolver[uuid.UUID]
+	teamIDsOnce sync.Once
 }
 
-// NewClient builds an teams client from the passed config.
-func NewClient(cfg *up.Config) *Client {
-	return &Client{
-		cfg,
+// A ClientOption modifies a teams client.
+type ClientOption func(*Client)
+
+// WithTeamIDResolver sets the Resolver used to resolve team names to IDs, so
+// that clients built with the same Resolver share its cache. Use
+// NewTeamIDResolver to build one.
+func WithTeamIDResolver(r *common.Resolver[uuid.UUID]) ClientOption {
+	return func(c *Client) {
+		c.teamIDs = r
 	}
 }
 
+// NewClient builds an teams client from the passed config. It resolves team
+// names with a Resolver of its own unless one is supplied with
+// WithTeamIDResolver.
+func NewClient(cfg *up.Config, opts ...ClientOption) *Client {
+	c := &Client{Config: cfg}
+	for _, o := range opts {
+		o(c)
+	}
+	return c
+}
+
+// resolver returns the Resolver of the client, building one on first use if
+// none was supplied, such as for a Client that was not built with NewClient.
+func (c *Client) resolver() *common.Resolver[uuid.UUID] {
+	c.teamIDsOnce.Do(func() {
+		if c.teamIDs == nil {
+			c.teamIDs = NewTeamIDResolver(c.Config)
+		}
+	})
+	return c.teamIDs
+}
+
+// NewTeamIDResolver builds a Resolver that resolves team names to IDs by
+// listing the teams of an organization. Its scopes are organization IDs. It
+// can be shared by clients with WithTeamIDResolver.
+func NewTeamIDResolver(cfg *up.Config, opts ...common.ResolverOption) *common.Resolver[uuid.UUID] {
+	return common.NewResolver((&Client{Config: cfg}).listTeamIDs, opts...)
+}
+
 // Create creates a team on Upbound.
 func (c *Client) Create(ctx context.Context, params *TeamCreateParameters) (*TeamResponse, error) { // nolint:interfacer
 	req, err := c.Client.NewRequest(ctx, http.MethodPost, basePath, "", params)
@@ -64,11 +115,159 @@ func (c *Client) Get(ctx context.Context, id uuid.UUID) (*TeamResponse, error) {
 	return t, c.Client.Do(req, t)
 }
 
+// List the teams in the organization on Upbound.
+func (c *Client) List(ctx context.Context, orgID uint, opts ...common.ListOption) (*TeamListResponse, error) {
+	req, err := c.Client.NewRequest(ctx, http.MethodGet, basePath, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	q := req.URL.Query()
+	q.Set(organizationIDParam, strconv.FormatUint(uint64(orgID), 10))
+	req.URL.RawQuery = q.Encode()
+	for _, o := range opts {
+		o(req)
+	}
+	t := &TeamListResponse{}
+	err = c.Client.Do(req, t)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ListAll returns an iterator over every team in the organization on Upbound,
+// fetching pages as it advances.
+func (c *Client) ListAll(ctx context.Context, orgID uint, opts ...common.ListOption) iter.Seq2[TeamResponse, error] {
+	return common.NewPager(func(ctx context.Context, opts ...common.ListOption) ([]TeamResponse, int, error) {
+		res, err := c.List(ctx, orgID, opts...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.Teams, res.Size, nil
+	}, opts...).All(ctx)
+}
+
+// GetTeamID returns the ID of the team with the given name in the
+// organization. IDs are cached, so repeated calls do not list every team.
+func (c *Client) GetTeamID(ctx context.Context, orgID uint, name string) (uuid.UUID, error) {
+	id, ok, err := c.resolver().Resolve(ctx, strconv.FormatUint(uint64(orgID), 10), name)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if !ok {
+		return uuid.Nil, errors.New(errTeamNotFound)
+	}
+	return id, nil
+}
+
+// listTeamIDs maps the name of every team in the organization whose ID is the
+// scope to its ID.
+func (c *Client) listTeamIDs(ctx context.Context, scope string) (map[string]uuid.UUID, error) {
+	orgID, err := strconv.ParseUint(scope, 10, 0)
+	if err != nil {
+		return nil, err
+	}
+	ids := map[string]uuid.UUID{}
+	for t, err := range c.ListAll(ctx, uint(orgID)) {
+		if err != nil {
+			return nil, err
+		}
+		ids[t.Name] = t.ID
+	}
+	return ids, nil
+}
+
+// Update the name and description of a team on Upbound.
+func (c *Client) Update(ctx context.Context, id uuid.UUID, params *TeamAttributes) (*TeamResponse, error) {
+	req, err := c.Client.NewRequest(ctx, http.MethodPatch, basePath, id.String(), params)
+	if err != nil {
+		return nil, err
+	}
+	t := &TeamResponse{}
+	if err := c.Client.Do(req, t); err != nil {
+		return nil, err
+	}
+	c.resolver().Reset()
+	return t, nil
+}
+
 // Delete delete an team on Upbound.
 func (c *Client) Delete(ctx context.Context, id uuid.UUID) error { // nolint:interfacer
 	req, err := c.Client.NewRequest(ctx, http.MethodDelete, basePath, id.String(), nil)
 	if err != nil {
 		return err
 	}
+	if err := c.Client.Do(req, nil); err != nil {
+		return err
+	}
+	c.resolver().Reset()
+	return nil
+}
+
+// ListMembers lists the users that are members of a team on Upbound.
+func (c *Client) ListMembers(ctx context.Context, id uuid.UUID) ([]TeamMember, error) {
+	req, err := c.Client.NewRequest(ctx, http.MethodGet, basePath, path.Join(id.String(), membersPath), nil)
+	if err != nil {
+		return nil, err
+	}
+	m := []TeamMember{}
+	err = c.Client.Do(req, &m)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AddMember adds a user to a team on Upbound.
+func (c *Client) AddMember(ctx context.Context, id uuid.UUID, params *TeamMemberParameters) error {
+	req, err := c.Client.NewRequest(ctx, http.MethodPost, basePath, path.Join(id.String(), membersPath), params)
+	if err != nil {
+		return err
+	}
+	return c.Client.Do(req, nil)
+}
+
+// RemoveMember removes a user from a team on Upbound.
+func (c *Client) RemoveMember(ctx context.Context, id uuid.UUID, userID uint) error {
+	req, err := c.Client.NewRequest(ctx, http.MethodDelete, basePath, path.Join(id.String(), membersPath, strconv.FormatUint(uint64(userID), 10)), nil)
+	if err != nil {
+		return err
+	}
+	return c.Client.Do(req, nil)
+}
+
+// ListRobots lists the robots that are members of a team on Upbound.
+func (c *Client) ListRobots(ctx context.Context, id uuid.UUID) ([]TeamRobot, error) {
+	req, err := c.Client.NewRequest(ctx, http.MethodGet, basePath, path.Join(id.String(), robotsPath), nil)
+	if err != nil {
+		return nil, err
+	}
+	r := []TeamRobot{}
+	err = c.Client.Do(req, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// AddRobot adds a robot to a team on Upbound.
+func (c *Client) AddRobot(ctx context.Context, id, robotID uuid.UUID) error {
+	return c.robotMembership(ctx, http.MethodPost, id, robotID)
+}
+
+// RemoveRobot removes a robot from a team on Upbound.
+func (c *Client) RemoveRobot(ctx context.Context, id, robotID uuid.UUID) error {
+	return c.robotMembership(ctx, http.MethodDelete, id, robotID)
+}
+
+// robotMembership modifies the team relationships of a robot, which is how
+// robot team membership is managed by the API.
+func (c *Client) robotMembership(ctx context.Context, method string, id, robotID uuid.UUID) error {
+	req, err := c.Client.NewRequest(ctx, method, robotsBasePath, path.Join(robotID.String(), teamsRelationPath), &robotTeams{
+		Data: []robotTeam{{Type: teamsType, ID: id.String()}},
+	})
+	if err != nil {
+		return err
+	}
 	return c.Client.Do(req, nil)
 }
@@ -17,14 +17,17 @@ package teams
 import (
 	"context"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	"github.com/upbound/up-sdk-go"
 	"github.com/upbound/up-sdk-go/fake"
+	"github.com/upbound/up-sdk-go/service/common"
 )
 
 func TestCreate(t *testing.T) {
@@ -330,3 +333,194 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestList(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason    string
+		opts      []common.ListOption
+		doErr     error
+		wantQuery string
+		want      *TeamListResponse
+		err       error
+	}{
+		"DoFailed": {
+			reason:    "Failing to execute request should return an error.",
+			doErr:     errBoom,
+			wantQuery: "organizationId=7",
+			err:       errBoom,
+		},
+		"Successful": {
+			reason:    "A successful request should list the teams in the organization.",
+			opts:      []common.ListOption{common.WithSize(10), common.WithPage(2)},
+			wantQuery: "organizationId=7&page=2&size=10",
+			want:      &TeamListResponse{Teams: []TeamResponse{{Name: "team"}}, Size: 10, Page: 2, Count: 1},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var query string
+			c := NewClient(&up.Config{
+				Client: &fake.MockClient{
+					MockNewRequest: func(_ context.Context, method, prefix, urlPath string, _ interface{}) (*http.Request, error) {
+						if method != http.MethodGet || prefix != basePath || urlPath != "" {
+							t.Errorf("unexpected request: %s %s/%s", method, prefix, urlPath)
+						}
+						return httptest.NewRequest(method, "/"+prefix, nil), nil
+					},
+					MockDo: func(req *http.Request, obj interface{}) error {
+						query = req.URL.RawQuery
+						if tc.doErr != nil {
+							return tc.doErr
+						}
+						*obj.(*TeamListResponse) = *tc.want
+						return nil
+					},
+				},
+			})
+			res, err := c.List(context.Background(), 7, tc.opts...)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nList(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, res); diff != "" {
+				t.Errorf("\n%s\nList(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.wantQuery, query); diff != "" {
+				t.Errorf("\n%s\nList(...): -want query, +got query:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestClientWithoutResolver(t *testing.T) {
+	uid := uuid.MustParse("4654b8b5-c01d-4fbe-8800-22c347c21383")
+	lists := 0
+	c := &Client{Config: &up.Config{
+		Client: &fake.MockClient{
+			MockNewRequest: func(_ context.Context, method, prefix, _ string, _ interface{}) (*http.Request, error) {
+				return httptest.NewRequest(method, "/"+prefix, nil), nil
+			},
+			MockDo: func(_ *http.Request, obj interface{}) error {
+				if res, ok := obj.(*TeamListResponse); ok {
+					lists++
+					*res = TeamListResponse{Teams: []TeamResponse{{ID: uid, Name: "team"}}, Size: 10, Count: 1}
+				}
+				return nil
+			},
+		},
+	}}
+	id, err := c.GetTeamID(context.Background(), 7, "team")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(uid, id); diff != "" {
+		t.Errorf("\nGetTeamID(...): -want, +got:\n%s", diff)
+	}
+	if _, err := c.Update(context.Background(), id, &TeamAttributes{Name: "renamed"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Delete(context.Background(), id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetTeamID(context.Background(), 7, "team"); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(2, lists); diff != "" {
+		t.Errorf("\nGetTeamID(...): a Client built without NewClient should cache IDs until they are reset: -want lists, +got lists:\n%s", diff)
+	}
+}
+
+func TestMembership(t *testing.T) {
+	uid := uuid.MustParse("4654b8b5-c01d-4fbe-8800-22c347c21383")
+	rid := uuid.MustParse("b1a5c1c5-3a5e-4b8a-9b3a-6d0f1b6d2f11")
+
+	type want struct {
+		method string
+		prefix string
+		path   string
+		body   interface{}
+	}
+	cases := map[string]struct {
+		reason string
+		call   func(c *Client) error
+		want   want
+	}{
+		"Update": {
+			reason: "Updating a team should patch its attributes.",
+			call: func(c *Client) error {
+				_, err := c.Update(context.Background(), uid, &TeamAttributes{Name: "team", Description: "desc"})
+				return err
+			},
+			want: want{method: http.MethodPatch, prefix: basePath, path: uid.String(), body: &TeamAttributes{Name: "team", Description: "desc"}},
+		},
+		"ListMembers": {
+			reason: "Listing members should get the members of the team.",
+			call: func(c *Client) error {
+				_, err := c.ListMembers(context.Background(), uid)
+				return err
+			},
+			want: want{method: http.MethodGet, prefix: basePath, path: uid.String() + "/members"},
+		},
+		"AddMember": {
+			reason: "Adding a member should post the user to the team.",
+			call: func(c *Client) error {
+				return c.AddMember(context.Background(), uid, &TeamMemberParameters{UserID: 3})
+			},
+			want: want{method: http.MethodPost, prefix: basePath, path: uid.String() + "/members", body: &TeamMemberParameters{UserID: 3}},
+		},
+		"RemoveMember": {
+			reason: "Removing a member should delete the user from the team.",
+			call: func(c *Client) error {
+				return c.RemoveMember(context.Background(), uid, 3)
+			},
+			want: want{method: http.MethodDelete, prefix: basePath, path: uid.String() + "/members/3"},
+		},
+		"ListRobots": {
+			reason: "Listing robots should get the robots of the team.",
+			call: func(c *Client) error {
+				_, err := c.ListRobots(context.Background(), uid)
+				return err
+			},
+			want: want{method: http.MethodGet, prefix: basePath, path: uid.String() + "/robots"},
+		},
+		"AddRobot": {
+			reason: "Adding a robot should create a team relationship for the robot.",
+			call: func(c *Client) error {
+				return c.AddRobot(context.Background(), uid, rid)
+			},
+			want: want{method: http.MethodPost, prefix: robotsBasePath, path: rid.String() + "/relationships/teams", body: &robotTeams{
+				Data: []robotTeam{{Type: teamsType, ID: uid.String()}},
+			}},
+		},
+		"RemoveRobot": {
+			reason: "Removing a robot should delete the team relationship of the robot.",
+			call: func(c *Client) error {
+				return c.RemoveRobot(context.Background(), uid, rid)
+			},
+			want: want{method: http.MethodDelete, prefix: robotsBasePath, path: rid.String() + "/relationships/teams", body: &robotTeams{
+				Data: []robotTeam{{Type: teamsType, ID: uid.String()}},
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got want
+			c := NewClient(&up.Config{
+				Client: &fake.MockClient{
+					MockNewRequest: func(_ context.Context, method, prefix, urlPath string, body interface{}) (*http.Request, error) {
+						got = want{method: method, prefix: prefix, path: urlPath, body: body}
+						return nil, nil
+					},
+					MockDo: fake.NewMockDoFn(nil),
+				},
+			})
+			if err := tc.call(c); err != nil {
+				t.Errorf("\n%s\nunexpected error:\n%v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\n-want request, +got request:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
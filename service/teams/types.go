@@ -32,6 +32,7 @@ type TeamResponse struct {
 	OrganizationID uint       `json:"organizationId"`
 	AccountID      uint       `json:"accountId"`
 	Name           string     `json:"name"`
+	Description    string     `json:"description,omitempty"`
 	CreatorID      uint       `json:"creatorId"`
 	CreatedAt      *time.Time `json:"createdAt,omitempty"`
 }
@@ -41,3 +42,45 @@ type TeamAttributes struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
 }
+
+// TeamListResponse is the HTTP body returned when listing teams.
+type TeamListResponse struct {
+	Teams []TeamResponse `json:"teams"`
+	Size  int            `json:"size"`
+	Page  int            `json:"page"`
+	Count int            `json:"count"`
+}
+
+// TeamMemberParameters are the parameters for adding a user to a team.
+type TeamMemberParameters struct {
+	UserID uint `json:"userId"`
+}
+
+// TeamMember is a user that is a member of a team.
+type TeamMember struct {
+	ID       uint       `json:"id"`
+	Username string     `json:"username"`
+	Name     string     `json:"name"`
+	Email    string     `json:"email"`
+	JoinedAt *time.Time `json:"joinedAt,omitempty"`
+}
+
+// TeamRobot is a robot that is a member of a team.
+type TeamRobot struct {
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+}
+
+// robotTeams is the body of a request that modifies the team relationships of
+// a robot.
+type robotTeams struct {
+	Data []robotTeam `json:"data"`
+}
+
+// robotTeam identifies a team a robot is related to.
+type robotTeam struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
@@ -0,0 +1,199 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// instrumentationName identifies the SDK as the source of spans and
+	// metrics.
+	instrumentationName = "github.com/upbound/up-sdk-go"
+
+	// ServicePrefixKey is the attribute recording the service prefix a
+	// request was built for, e.g. v1/controlPlanes.
+	ServicePrefixKey = attribute.Key("upbound.service.prefix")
+
+	// RateLimitWaitKey is the attribute recording how long, in seconds, a
+	// request waited on the client's RateLimiter before it was sent.
+	RateLimitWaitKey = attribute.Key("upbound.ratelimit.wait")
+
+	metricRequestDuration = "upbound.client.request.duration"
+	metricRequestErrors   = "upbound.client.request.errors"
+)
+
+// WithTracerProvider creates a client span for every request sent through the
+// ContextTransport using the supplied TracerProvider. The span context is
+// propagated to the target with the W3C traceparent header unless another
+// propagator is configured with WithPropagator.
+func WithTracerProvider(tp trace.TracerProvider) ContextTransportOption {
+	return func(ct *ContextTransport) {
+		ct.tracer = tp.Tracer(instrumentationName)
+		if ct.propagator == nil {
+			ct.propagator = propagation.TraceContext{}
+		}
+	}
+}
+
+// WithPropagator sets the propagator used to inject the span context of
+// traced requests.
+func WithPropagator(p propagation.TextMapPropagator) ContextTransportOption {
+	return func(ct *ContextTransport) {
+		ct.propagator = p
+	}
+}
+
+// WithMeterProvider records the latency of every request sent through the
+// ContextTransport, and counts failed requests, using the supplied
+// MeterProvider. Both are recorded per service prefix.
+func WithMeterProvider(mp metric.MeterProvider) ContextTransportOption {
+	return func(ct *ContextTransport) {
+		m := mp.Meter(instrumentationName)
+		var err error
+		ct.duration, err = m.Float64Histogram(metricRequestDuration,
+			metric.WithDescription("Duration of requests sent to Upbound."),
+			metric.WithUnit("s"),
+		)
+		if err != nil {
+			otel.Handle(err)
+		}
+		ct.errors, err = m.Int64Counter(metricRequestErrors,
+			metric.WithDescription("Number of requests sent to Upbound that failed or returned an error status."),
+			metric.WithUnit("{request}"),
+		)
+		if err != nil {
+			otel.Handle(err)
+		}
+	}
+}
+
+// startSpan starts a client span for the request if tracing is enabled, and
+// injects its context into the request headers.
+func (c *ContextTransport) startSpan(req *http.Request) (*http.Request, trace.Span) {
+	if c.tracer == nil {
+		return req, nil
+	}
+	name := req.Method
+	if p := servicePrefix(req.Context()); p != "" {
+		name += " " + p
+	}
+	ctx, span := c.tracer.Start(req.Context(), name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(requestAttributes(req)...),
+		trace.WithAttributes(semconv.URLFull(redactURL(req))),
+	)
+	// The span starts once the request is sent, so it records the time spent
+	// waiting on the RateLimiter before then.
+	if wait := RateLimitWaitFromContext(ctx); wait > 0 {
+		span.SetAttributes(RateLimitWaitKey.Float64(wait.Seconds()))
+	}
+	req = req.WithContext(ctx)
+	if c.propagator != nil {
+		c.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+	}
+	return req, span
+}
+
+// endSpan records the outcome of the request on the span.
+func endSpan(span trace.Span, res *http.Response, err error) {
+	if span == nil {
+		return
+	}
+	defer span.End()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.SetAttributes(semconv.ErrorType(err))
+		return
+	}
+	span.SetAttributes(semconv.HTTPResponseStatusCode(res.StatusCode))
+	if res.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, http.StatusText(res.StatusCode))
+		span.SetAttributes(semconv.ErrorTypeKey.String(strconv.Itoa(res.StatusCode)))
+	}
+}
+
+// record records the latency and outcome of the request if metrics are
+// enabled.
+func (c *ContextTransport) record(ctx context.Context, req *http.Request, res *http.Response, err error, latency time.Duration) {
+	if c.duration == nil && c.errors == nil {
+		return
+	}
+	attrs := append(requestAttributes(req), ServicePrefixKey.String(servicePrefix(req.Context())))
+	failed := err != nil
+	switch {
+	case err != nil:
+		attrs = append(attrs, semconv.ErrorType(err))
+	case res.StatusCode >= http.StatusBadRequest:
+		failed = true
+		attrs = append(attrs, semconv.HTTPResponseStatusCode(res.StatusCode), semconv.ErrorTypeKey.String(strconv.Itoa(res.StatusCode)))
+	default:
+		attrs = append(attrs, semconv.HTTPResponseStatusCode(res.StatusCode))
+	}
+	set := metric.WithAttributeSet(attribute.NewSet(attrs...))
+	if c.duration != nil {
+		c.duration.Record(ctx, latency.Seconds(), set)
+	}
+	if c.errors != nil && failed {
+		c.errors.Add(ctx, 1, set)
+	}
+}
+
+// servicePrefix returns the static base path of the service prefix a request
+// was built for, e.g. v1/repoPermissions for v1/repoPermissions/acme/teams/id,
+// so that span names and metric attributes never include identifiers. Base
+// paths are a version and a service, or apis, a group and a version.
+func servicePrefix(ctx context.Context) string {
+	p := prefixFromContext(ctx)
+	n := 2
+	if strings.HasPrefix(p, "apis/") {
+		n = 3
+	}
+	parts := strings.SplitN(p, "/", n+1)
+	return strings.Join(parts[:min(n, len(parts))], "/")
+}
+
+// requestAttributes returns the low cardinality attributes describing the
+// request.
+func requestAttributes(req *http.Request) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String(req.Method),
+		semconv.ServerAddress(req.URL.Hostname()),
+	}
+	if p, err := strconv.Atoi(req.URL.Port()); err == nil {
+		attrs = append(attrs, semconv.ServerPort(p))
+	}
+	return attrs
+}
+
+// redactURL returns the URL of the request without any user credentials.
+func redactURL(req *http.Request) string {
+	u := *req.URL
+	u.User = nil
+	return u.String()
+}
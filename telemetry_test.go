@@ -0,0 +1,241 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/upbound/up-sdk-go/http/headers"
+)
+
+func TestContextTransportTracing(t *testing.T) {
+	type want struct {
+		name        string
+		kind        trace.SpanKind
+		status      codes.Code
+		statusCode  int64
+		traceparent bool
+		requestID   bool
+	}
+	cases := map[string]struct {
+		reason string
+		status int
+		want   want
+	}{
+		"Success": {
+			reason: "A client span should be recorded and propagated with the request.",
+			status: http.StatusOK,
+			want: want{
+				name:        "GET v1/controlPlanes",
+				kind:        trace.SpanKindClient,
+				status:      codes.Unset,
+				statusCode:  http.StatusOK,
+				traceparent: true,
+				requestID:   true,
+			},
+		},
+		"ErrorStatus": {
+			reason: "A span for a request that returned an error status should be marked as failed.",
+			status: http.StatusNotFound,
+			want: want{
+				name:        "GET v1/controlPlanes",
+				kind:        trace.SpanKindClient,
+				status:      codes.Error,
+				statusCode:  http.StatusNotFound,
+				traceparent: true,
+				requestID:   true,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var traceparent, requestID string
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				traceparent = r.Header.Get("traceparent")
+				requestID = r.Header.Get(headers.RequestIDHeader)
+				w.WriteHeader(tc.status)
+			}))
+			defer s.Close()
+
+			sr := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+			u, _ := url.Parse(s.URL)
+			c := NewClient(func(c *HTTPClient) {
+				c.BaseURL = u
+				c.HTTP = &http.Client{Transport: NewContextTransport(WithTracerProvider(tp))}
+			})
+			req, _ := c.NewRequest(context.Background(), http.MethodGet, "v1/controlPlanes", "acct", nil)
+			_ = c.Do(req, nil)
+
+			spans := sr.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("\n%s\nDo(...): expected one span, got %d", tc.reason, len(spans))
+			}
+			span := spans[0]
+			var statusCode int64
+			for _, a := range span.Attributes() {
+				if a.Key == semconv.HTTPResponseStatusCodeKey {
+					statusCode = a.Value.AsInt64()
+				}
+			}
+			got := want{
+				name:        span.Name(),
+				kind:        span.SpanKind(),
+				status:      span.Status().Code,
+				statusCode:  statusCode,
+				traceparent: traceparent != "" && traceparent[3:35] == span.SpanContext().TraceID().String(),
+				requestID:   requestID != "",
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nDo(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestContextTransportRateLimitWait(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	c := NewClient(func(c *HTTPClient) {
+		c.HTTP = &http.Client{Transport: NewContextTransport(
+			WithTransport(roundTripperFn(func(_ *http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+			})),
+			WithTracerProvider(tp),
+		)}
+	}, WithRateLimiter(NewRateLimiter(RateLimit{QPS: 20, Burst: 1})))
+	for range 2 {
+		req, _ := c.NewRequest(context.Background(), http.MethodGet, "v1", "self", nil)
+		if err := c.Do(req, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	spans := sr.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("\nDo(...): expected two spans, got %d", len(spans))
+	}
+	var wait float64
+	for _, a := range spans[1].Attributes() {
+		if a.Key == RateLimitWaitKey {
+			wait = a.Value.AsFloat64()
+		}
+	}
+	if wait < 0.02 {
+		t.Errorf("\nDo(...): the span of a request that waited on the RateLimiter should record the wait, got %vs", wait)
+	}
+}
+
+func TestContextTransportMetrics(t *testing.T) {
+	statuses := map[string]int{"/v1/controlPlanes/acct": http.StatusOK, "/v2/robots/id": http.StatusServiceUnavailable, "/v1/repoPermissions/acme/teams/id": http.StatusOK}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statuses[r.URL.Path])
+	}))
+	defer s.Close()
+
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	u, _ := url.Parse(s.URL)
+	c := NewClient(func(c *HTTPClient) {
+		c.BaseURL = u
+		c.HTTP = &http.Client{Transport: NewContextTransport(WithMeterProvider(mp))}
+	})
+	for _, p := range [][2]string{{"v1/controlPlanes", "acct"}, {"v2/robots", "id"}, {"v2/robots", "id"}, {"v1/repoPermissions/acme/teams/id", ""}} {
+		req, _ := c.NewRequest(context.Background(), http.MethodGet, p[0], p[1], nil)
+		_ = c.Do(req, nil)
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	durations := map[string]uint64{}
+	failures := map[string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch d := m.Data.(type) {
+			case metricdata.Histogram[float64]:
+				for _, dp := range d.DataPoints {
+					durations[prefixAttribute(dp.Attributes)] += dp.Count
+				}
+			case metricdata.Sum[int64]:
+				for _, dp := range d.DataPoints {
+					failures[prefixAttribute(dp.Attributes)] += dp.Value
+				}
+			}
+		}
+	}
+	if diff := cmp.Diff(map[string]uint64{"v1/controlPlanes": 1, "v2/robots": 2, "v1/repoPermissions": 1}, durations); diff != "" {
+		t.Errorf("\nDo(...): latency should be recorded per service prefix: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]int64{"v2/robots": 2}, failures); diff != "" {
+		t.Errorf("\nDo(...): errors should be counted per service prefix: -want, +got:\n%s", diff)
+	}
+}
+
+func TestServicePrefix(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		prefix string
+		want   string
+	}{
+		"Static": {
+			reason: "A static base path should be kept as is.",
+			prefix: "v1/controlPlanes",
+			want:   "v1/controlPlanes",
+		},
+		"Identifiers": {
+			reason: "Identifiers after the base path should be dropped.",
+			prefix: "/v1/repoPermissions/acme/teams/id/",
+			want:   "v1/repoPermissions",
+		},
+		"APIs": {
+			reason: "A Kubernetes style base path should keep its group and version.",
+			prefix: "apis/spaces.upbound.io/v1beta1/namespaces/default",
+			want:   "apis/spaces.upbound.io/v1beta1",
+		},
+		"Empty": {
+			reason: "An empty prefix should stay empty.",
+			want:   "",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := servicePrefix(withPrefix(context.Background(), tc.prefix))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nservicePrefix(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func prefixAttribute(s attribute.Set) string {
+	v, _ := s.Value(ServicePrefixKey)
+	return v.AsString()
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+	"github.com/upbound/up-sdk-go/service/controlplanes"
+	"github.com/upbound/up-sdk-go/service/spaces"
+)
+
+const errWatch = "cannot watch object"
+
+// ForControlPlane polls an Upbound control plane until it is ready. It fails
+// with a FailedError if the control plane is deleted instead.
+func ForControlPlane(ctx context.Context, c *controlplanes.Client, account, name string, opts ...Option) (*controlplanes.ControlPlaneResponse, error) {
+	return Poll(ctx, func(ctx context.Context) (*controlplanes.ControlPlaneResponse, error) {
+		return c.Get(ctx, account, name)
+	}, StatusIs(controlplanes.StatusReady), opts...)
+}
+
+// ForSpacesControlPlane watches a Spaces control plane until the predicate is
+// satisfied, e.g. ControlPlaneReady.
+func ForSpacesControlPlane(ctx context.Context, c *spaces.ResourceClient[*spacesv1beta1.ControlPlane, *spacesv1beta1.ControlPlaneList], namespace, name string, p Predicate[*spacesv1beta1.ControlPlane], opts ...Option) (*spacesv1beta1.ControlPlane, error) {
+	return ForObject(ctx, c, namespace, name, p, opts...)
+}
+
+// A Watcher watches objects in a namespace. It is satisfied by
+// spaces.ResourceClient.
+type Watcher interface {
+	Watch(ctx context.Context, namespace string, opts *metav1.ListOptions) (watch.Interface, error)
+}
+
+// ForObject watches the named object until the predicate is satisfied. The
+// watch starts with the current state of the object, so it is satisfied
+// straight away if the object already reached the desired state.
+func ForObject[T runtime.Object](ctx context.Context, c Watcher, namespace, name string, p Predicate[T], opts ...Option) (T, error) {
+	w, err := c.Watch(ctx, namespace, &metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("metadata.name", name).String(),
+	})
+	if err != nil {
+		var zero T
+		return zero, errors.Wrap(err, errWatch)
+	}
+	return Watch(ctx, w, p, opts...)
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"fmt"
+	"slices"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+	"github.com/upbound/up-sdk-go/service/controlplanes"
+)
+
+// A Predicate reports whether an object has reached the desired state. It
+// also describes what it observed, which is reported if the wait times out.
+// It returns an error, usually a FailedError, to stop the wait if the object
+// will not reach the desired state.
+type Predicate[T any] func(obj T) (done bool, observed string, err error)
+
+// A Conditioned object reports Crossplane conditions, like a Spaces
+// ControlPlane.
+type Conditioned interface {
+	GetCondition(ct xpv1.ConditionType) xpv1.Condition
+}
+
+// ConditionTrue is satisfied when the condition of the supplied type is true.
+func ConditionTrue[T Conditioned](ct xpv1.ConditionType) Predicate[T] {
+	return func(obj T) (bool, string, error) {
+		c := obj.GetCondition(ct)
+		return c.Status == corev1.ConditionTrue, describe(c), nil
+	}
+}
+
+// FailOn fails the wait when the condition of the supplied type is false for
+// one of the supplied reasons. It is satisfied otherwise, so it is meant to
+// precede other predicates in AllOf.
+func FailOn[T Conditioned](ct xpv1.ConditionType, reasons ...xpv1.ConditionReason) Predicate[T] {
+	return func(obj T) (bool, string, error) {
+		c := obj.GetCondition(ct)
+		if c.Status == corev1.ConditionFalse && slices.Contains(reasons, c.Reason) {
+			return false, describe(c), &FailedError{Observed: describe(c)}
+		}
+		return true, describe(c), nil
+	}
+}
+
+// AllOf is satisfied when every supplied predicate is satisfied. It reports
+// what the first unsatisfied predicate observed, and stops at the first
+// predicate that returns an error.
+func AllOf[T any](ps ...Predicate[T]) Predicate[T] {
+	return func(obj T) (bool, string, error) {
+		observed := ""
+		for _, p := range ps {
+			done, o, err := p(obj)
+			if !done || err != nil {
+				return false, o, err
+			}
+			observed = o
+		}
+		return true, observed, nil
+	}
+}
+
+// ControlPlaneReady is satisfied when a Spaces control plane is provisioned
+// and healthy. It fails if the control plane cannot be reconciled or
+// provisioned.
+func ControlPlaneReady() Predicate[*spacesv1beta1.ControlPlane] {
+	return AllOf(
+		FailOn[*spacesv1beta1.ControlPlane](xpv1.TypeSynced, xpv1.ReasonReconcileError),
+		FailOn[*spacesv1beta1.ControlPlane](spacesv1beta1.ConditionTypeControlPlaneProvisioned, spacesv1beta1.ReasonProvisioningError),
+		ConditionTrue[*spacesv1beta1.ControlPlane](spacesv1beta1.ConditionTypeControlPlaneProvisioned),
+		ConditionTrue[*spacesv1beta1.ControlPlane](spacesv1beta1.ConditionTypeHealthy),
+	)
+}
+
+// StatusIs is satisfied when an Upbound control plane has the supplied
+// status. It fails if the control plane is being deleted, unless that is the
+// supplied status.
+func StatusIs(s controlplanes.Status) Predicate[*controlplanes.ControlPlaneResponse] {
+	return func(cp *controlplanes.ControlPlaneResponse) (bool, string, error) {
+		observed := fmt.Sprintf("status %q", cp.Status)
+		if cp.Status == controlplanes.StatusDeleting && s != controlplanes.StatusDeleting {
+			return false, observed, &FailedError{Observed: observed}
+		}
+		return cp.Status == s, observed, nil
+	}
+}
+
+func describe(c xpv1.Condition) string {
+	s := fmt.Sprintf("%s=%s", c.Type, c.Status)
+	switch {
+	case c.Reason != "" && c.Message != "":
+		s += fmt.Sprintf(" (%s: %s)", c.Reason, c.Message)
+	case c.Reason != "":
+		s += fmt.Sprintf(" (%s)", c.Reason)
+	}
+	return s
+}
@@ -0,0 +1,217 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wait waits for Upbound and Spaces resources to reach a desired
+// state, either by polling with backoff or by watching them.
+package wait
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	uerrors "github.com/upbound/up-sdk-go/errors"
+)
+
+const (
+	// DefaultInterval is how long to wait before polling again by default.
+	// The interval doubles after every poll up to the maximum interval.
+	DefaultInterval = time.Second
+
+	// DefaultMaxInterval is the longest interval between polls by default.
+	DefaultMaxInterval = 30 * time.Second
+
+	errGet         = "cannot get object"
+	errWatchClosed = "watch closed before the condition was met"
+	errUnexpected  = "unexpected object of type %T in watch event"
+)
+
+// ErrDeleted is returned when a watched object is deleted before the
+// condition is met.
+var ErrDeleted = errors.New("object was deleted before the condition was met")
+
+// A TimeoutError is returned when the context is done before the condition
+// is met. It unwraps to the context's error.
+type TimeoutError struct {
+	// Observed describes the state last observed, as reported by the
+	// predicate. It is empty if the object was never observed.
+	Observed string
+
+	// Err is the context's error.
+	Err error
+}
+
+// Error returns the last observed state.
+func (e *TimeoutError) Error() string {
+	if e.Observed == "" {
+		return fmt.Sprintf("%v: the object was never observed", e.Err)
+	}
+	return fmt.Sprintf("%v: last observed %s", e.Err, e.Observed)
+}
+
+// Unwrap returns the context's error.
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// A FailedError is returned by a predicate when the object reached a state
+// from which it will not reach the desired state, e.g. a failed condition.
+type FailedError struct {
+	// Observed describes the failed state, as reported by the predicate.
+	Observed string
+}
+
+// Error returns the failed state.
+func (e *FailedError) Error() string {
+	return fmt.Sprintf("object failed: %s", e.Observed)
+}
+
+// An Option modifies how to wait.
+type Option func(*options)
+
+type options struct {
+	interval    time.Duration
+	maxInterval time.Duration
+	timeout     time.Duration
+}
+
+// WithInterval sets how long to wait before polling again after the first
+// poll. The interval doubles after every poll up to the maximum interval.
+// Non-positive intervals are ignored.
+func WithInterval(d time.Duration) Option {
+	return func(o *options) {
+		o.interval = d
+	}
+}
+
+// WithMaxInterval sets the longest interval between polls. It is raised to
+// the initial interval if it is shorter. Non-positive intervals are ignored.
+func WithMaxInterval(d time.Duration) Option {
+	return func(o *options) {
+		o.maxInterval = d
+	}
+}
+
+// WithTimeout sets how long to wait for the condition. By default the wait
+// is only bounded by the supplied context.
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.timeout = d
+	}
+}
+
+func newOptions(ctx context.Context, opts []Option) (context.Context, context.CancelFunc, *options) {
+	o := &options{interval: DefaultInterval, maxInterval: DefaultMaxInterval}
+	for _, fn := range opts {
+		fn(o)
+	}
+	// A zero interval would never grow and turn polling into a busy loop.
+	if o.interval <= 0 {
+		o.interval = DefaultInterval
+	}
+	if o.maxInterval <= 0 {
+		o.maxInterval = DefaultMaxInterval
+	}
+	o.maxInterval = max(o.maxInterval, o.interval)
+	if o.timeout > 0 {
+		ctx, cancel := context.WithTimeout(ctx, o.timeout)
+		return ctx, cancel, o
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return ctx, cancel, o
+}
+
+// Poll gets the object until the predicate is satisfied and returns it. Not
+// found errors are treated as the object not existing yet; any other error
+// stops polling, as does an error returned by the predicate, in which case
+// the object is returned alongside it. A TimeoutError is returned if the
+// context is done first.
+func Poll[T any](ctx context.Context, get func(ctx context.Context) (T, error), p Predicate[T], opts ...Option) (T, error) {
+	ctx, cancel, o := newOptions(ctx, opts)
+	defer cancel()
+
+	var zero T
+	observed := ""
+	interval := o.interval
+	for {
+		obj, err := get(ctx)
+		switch {
+		case err != nil && ctx.Err() != nil:
+			return zero, &TimeoutError{Observed: observed, Err: ctx.Err()}
+		case err != nil && !uerrors.IsNotFound(err):
+			return zero, errors.Wrap(err, errGet)
+		case err == nil:
+			var done bool
+			done, observed, err = p(obj)
+			if done || err != nil {
+				return obj, err
+			}
+		}
+
+		t := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return zero, &TimeoutError{Observed: observed, Err: ctx.Err()}
+		case <-t.C:
+		}
+		interval = min(interval*2, o.maxInterval)
+	}
+}
+
+// Watch consumes events from the watch until an object satisfies the
+// predicate and returns it. The watch is stopped when Watch returns.
+// ErrDeleted is returned if an object is deleted, and a TimeoutError if the
+// context is done first. An error returned by the predicate is returned
+// alongside the object. Only the timeout option applies to watches.
+func Watch[T runtime.Object](ctx context.Context, w watch.Interface, p Predicate[T], opts ...Option) (T, error) {
+	ctx, cancel, _ := newOptions(ctx, opts)
+	defer cancel()
+	defer w.Stop()
+
+	var zero T
+	observed := ""
+	for {
+		select {
+		case <-ctx.Done():
+			return zero, &TimeoutError{Observed: observed, Err: ctx.Err()}
+		case e, ok := <-w.ResultChan():
+			if !ok {
+				return zero, errors.New(errWatchClosed)
+			}
+			switch e.Type { //nolint:exhaustive // Bookmarks carry no state.
+			case watch.Error:
+				return zero, apierrors.FromObject(e.Object)
+			case watch.Deleted:
+				return zero, ErrDeleted
+			case watch.Added, watch.Modified:
+				obj, ok := e.Object.(T)
+				if !ok {
+					return zero, errors.Errorf(errUnexpected, e.Object)
+				}
+				var done bool
+				var err error
+				done, observed, err = p(obj)
+				if done || err != nil {
+					return obj, err
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+	uerrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/service/controlplanes"
+)
+
+func controlPlane(conditions ...xpv1.Condition) *spacesv1beta1.ControlPlane {
+	cp := &spacesv1beta1.ControlPlane{}
+	cp.SetConditions(conditions...)
+	return cp
+}
+
+func condition(ct xpv1.ConditionType, s corev1.ConditionStatus, reason xpv1.ConditionReason) xpv1.Condition {
+	return xpv1.Condition{Type: ct, Status: s, Reason: reason}
+}
+
+func TestPoll(t *testing.T) {
+	errBoom := errors.New("boom")
+	notFound := &uerrors.Error{Status: http.StatusNotFound}
+
+	type step struct {
+		status controlplanes.Status
+		err    error
+	}
+	type want struct {
+		gets int
+		err  error
+	}
+	cases := map[string]struct {
+		reason  string
+		steps   []step
+		timeout time.Duration
+		want    want
+	}{
+		"Ready": {
+			reason: "Polling should continue through missing and provisioning control planes until one is ready.",
+			steps:  []step{{err: notFound}, {status: controlplanes.StatusProvisioning}, {status: controlplanes.StatusReady}},
+			want:   want{gets: 3},
+		},
+		"Deleting": {
+			reason: "A control plane that is being deleted should stop polling.",
+			steps:  []step{{status: controlplanes.StatusProvisioning}, {status: controlplanes.StatusDeleting}},
+			want:   want{gets: 2, err: &FailedError{Observed: `status "deleting"`}},
+		},
+		"GetFailed": {
+			reason: "An error other than not found should stop polling.",
+			steps:  []step{{err: errBoom}},
+			want:   want{gets: 1, err: errors.Wrap(errBoom, errGet)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gets := 0
+			_, err := Poll(context.Background(), func(_ context.Context) (*controlplanes.ControlPlaneResponse, error) {
+				s := tc.steps[gets]
+				gets++
+				return &controlplanes.ControlPlaneResponse{Status: s.status}, s.err
+			}, StatusIs(controlplanes.StatusReady), WithInterval(time.Millisecond))
+			if diff := cmp.Diff(tc.want, want{gets: gets, err: err}, cmp.AllowUnexported(want{}), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nPoll(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPollTimeout(t *testing.T) {
+	_, err := Poll(context.Background(), func(_ context.Context) (*spacesv1beta1.ControlPlane, error) {
+		return controlPlane(
+			condition(spacesv1beta1.ConditionTypeControlPlaneProvisioned, corev1.ConditionTrue, "Provisioned"),
+			condition(spacesv1beta1.ConditionTypeHealthy, corev1.ConditionFalse, "Unhealthy"),
+		), nil
+	}, ControlPlaneReady(), WithInterval(time.Millisecond), WithTimeout(20*time.Millisecond))
+
+	want := &TimeoutError{Observed: "Healthy=False (Unhealthy)", Err: context.DeadlineExceeded}
+	if diff := cmp.Diff(want, err, test.EquateErrors()); diff != "" {
+		t.Errorf("\nPoll(...): -want error, +got error:\n%s", diff)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("\nPoll(...): want error to wrap context.DeadlineExceeded")
+	}
+}
+
+func TestWatch(t *testing.T) {
+	provisioned := condition(spacesv1beta1.ConditionTypeControlPlaneProvisioned, corev1.ConditionTrue, "Provisioned")
+	healthy := condition(spacesv1beta1.ConditionTypeHealthy, corev1.ConditionTrue, "Healthy")
+
+	type event struct {
+		t   watch.EventType
+		obj *spacesv1beta1.ControlPlane
+	}
+	cases := map[string]struct {
+		reason string
+		events []event
+		want   error
+	}{
+		"Ready": {
+			reason: "Events should be consumed until the control plane is ready.",
+			events: []event{
+				{watch.Added, controlPlane()},
+				{watch.Modified, controlPlane(provisioned)},
+				{watch.Modified, controlPlane(provisioned, healthy)},
+			},
+		},
+		"Deleted": {
+			reason: "Deleting the control plane should stop the wait.",
+			events: []event{{watch.Added, controlPlane()}, {watch.Deleted, controlPlane()}},
+			want:   ErrDeleted,
+		},
+		"Failed": {
+			reason: "A control plane that failed to provision should stop the wait.",
+			events: []event{
+				{watch.Added, controlPlane()},
+				{watch.Modified, controlPlane(condition(spacesv1beta1.ConditionTypeControlPlaneProvisioned, corev1.ConditionFalse, spacesv1beta1.ReasonProvisioningError))},
+			},
+			want: &FailedError{Observed: "ControlPlaneProvisioned=False (ProvisioningError)"},
+		},
+		"Closed": {
+			reason: "Closing the watch should stop the wait.",
+			events: []event{{watch.Added, controlPlane(provisioned)}},
+			want:   errors.New(errWatchClosed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			w := watch.NewFakeWithChanSize(len(tc.events), false)
+			for _, e := range tc.events {
+				w.Action(e.t, e.obj)
+			}
+			var failed *FailedError
+			if tc.want != nil && !errors.Is(tc.want, ErrDeleted) && !errors.As(tc.want, &failed) {
+				w.Stop()
+			}
+			_, err := Watch(context.Background(), w, ControlPlaneReady(), WithTimeout(5*time.Second))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nWatch(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAllOf(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		cp       *spacesv1beta1.ControlPlane
+		done     bool
+		observed string
+		err      error
+	}{
+		"Missing": {
+			reason:   "A missing condition should be observed as unknown.",
+			cp:       controlPlane(),
+			observed: "ControlPlaneProvisioned=Unknown",
+		},
+		"FirstUnsatisfied": {
+			reason:   "The first unsatisfied condition should be observed.",
+			cp:       controlPlane(condition(spacesv1beta1.ConditionTypeControlPlaneProvisioned, corev1.ConditionTrue, "Provisioned")),
+			observed: "Healthy=Unknown",
+		},
+		"ReconcileError": {
+			reason:   "A control plane that cannot be reconciled should fail.",
+			cp:       controlPlane(condition(xpv1.TypeSynced, corev1.ConditionFalse, xpv1.ReasonReconcileError)),
+			observed: "Synced=False (ReconcileError)",
+			err:      &FailedError{Observed: "Synced=False (ReconcileError)"},
+		},
+		"Ready": {
+			reason: "A provisioned and healthy control plane should be ready.",
+			cp: controlPlane(
+				condition(spacesv1beta1.ConditionTypeControlPlaneProvisioned, corev1.ConditionTrue, "Provisioned"),
+				condition(spacesv1beta1.ConditionTypeHealthy, corev1.ConditionTrue, "Healthy"),
+			),
+			done:     true,
+			observed: "Healthy=True (Healthy)",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			done, observed, err := ControlPlaneReady()(tc.cp)
+			if diff := cmp.Diff(tc.done, done); diff != "" {
+				t.Errorf("\n%s\nControlPlaneReady(...): -want done, +got done:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.observed, observed); diff != "" {
+				t.Errorf("\n%s\nControlPlaneReady(...): -want observed, +got observed:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nControlPlaneReady(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNewOptions(t *testing.T) {
+	type want struct {
+		interval    time.Duration
+		maxInterval time.Duration
+	}
+	cases := map[string]struct {
+		reason string
+		opts   []Option
+		want   want
+	}{
+		"Defaults": {
+			reason: "The default intervals should be used if none are supplied.",
+			want:   want{interval: DefaultInterval, maxInterval: DefaultMaxInterval},
+		},
+		"NonPositive": {
+			reason: "Non-positive intervals should be ignored rather than polling in a busy loop.",
+			opts:   []Option{WithInterval(0), WithMaxInterval(-time.Second)},
+			want:   want{interval: DefaultInterval, maxInterval: DefaultMaxInterval},
+		},
+		"MaxBelowInterval": {
+			reason: "The maximum interval should be raised to the initial interval.",
+			opts:   []Option{WithInterval(time.Minute), WithMaxInterval(time.Second)},
+			want:   want{interval: time.Minute, maxInterval: time.Minute},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, cancel, o := newOptions(context.Background(), tc.opts)
+			defer cancel()
+			got := want{interval: o.interval, maxInterval: o.maxInterval}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nnewOptions(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}